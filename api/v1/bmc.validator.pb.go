@@ -6,10 +6,12 @@ package v1
 import (
 	fmt "fmt"
 	math "math"
+	regexp "regexp"
 
 	proto "github.com/golang/protobuf/proto"
 	_ "github.com/mwitkow/go-proto-validators"
 	github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-validators"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -17,6 +19,8 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+var _regex_NetworkSourceRequest_CallbackUrl = regexp.MustCompile(`^$|^(?i:https?)://[^/?#\s]+`)
+
 func (this *NetworkSourceRequest) Validate() error {
 	if this.Authn != nil {
 		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Authn); err != nil {
@@ -31,11 +35,33 @@ func (this *NetworkSourceRequest) Validate() error {
 	if _, ok := NetworkSource_name[int32(this.NetworkSource)]; !ok {
 		return github_com_mwitkow_go_proto_validators.FieldError("NetworkSource", fmt.Errorf(`value '%v' must be a valid NetworkSource field`, this.NetworkSource))
 	}
+	if this.RetryPolicy != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.RetryPolicy); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("RetryPolicy", err)
+		}
+	}
+	if !_regex_NetworkSourceRequest_CallbackUrl.MatchString(this.CallbackUrl) {
+		return github_com_mwitkow_go_proto_validators.FieldError("CallbackUrl", fmt.Errorf(`value '%v' must be a string conforming to regex "^$|^(?i:https?)://[^/?#\\s]+"`, this.CallbackUrl))
+	}
+	if !(this.TimeoutMs > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("TimeoutMs", fmt.Errorf(`value '%v' must be greater than '-1'`, this.TimeoutMs))
+	}
+	if this.NotBefore != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.NotBefore); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("NotBefore", err)
+		}
+	}
+	if !(this.IpmiLanChannel < 16) {
+		return github_com_mwitkow_go_proto_validators.FieldError("IpmiLanChannel", fmt.Errorf(`value '%v' must be less than '16'`, this.IpmiLanChannel))
+	}
 	return nil
 }
 func (this *NetworkSourceResponse) Validate() error {
 	return nil
 }
+
+var _regex_ResetRequest_CallbackUrl = regexp.MustCompile(`^$|^(?i:https?)://[^/?#\s]+`)
+
 func (this *ResetRequest) Validate() error {
 	if this.Authn != nil {
 		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Authn); err != nil {
@@ -50,6 +76,22 @@ func (this *ResetRequest) Validate() error {
 	if _, ok := ResetKind_name[int32(this.ResetKind)]; !ok {
 		return github_com_mwitkow_go_proto_validators.FieldError("ResetKind", fmt.Errorf(`value '%v' must be a valid ResetKind field`, this.ResetKind))
 	}
+	if this.RetryPolicy != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.RetryPolicy); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("RetryPolicy", err)
+		}
+	}
+	if !_regex_ResetRequest_CallbackUrl.MatchString(this.CallbackUrl) {
+		return github_com_mwitkow_go_proto_validators.FieldError("CallbackUrl", fmt.Errorf(`value '%v' must be a string conforming to regex "^$|^(?i:https?)://[^/?#\\s]+"`, this.CallbackUrl))
+	}
+	if !(this.TimeoutMs > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("TimeoutMs", fmt.Errorf(`value '%v' must be greater than '-1'`, this.TimeoutMs))
+	}
+	if this.NotBefore != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.NotBefore); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("NotBefore", err)
+		}
+	}
 	return nil
 }
 func (this *ResetResponse) Validate() error {
@@ -67,6 +109,9 @@ func (this *UserCreds) Validate() error {
 	}
 	return nil
 }
+
+var _regex_CreateUserRequest_CallbackUrl = regexp.MustCompile(`^$|^(?i:https?)://[^/?#\s]+`)
+
 func (this *CreateUserRequest) Validate() error {
 	if this.Authn != nil {
 		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Authn); err != nil {
@@ -83,11 +128,30 @@ func (this *CreateUserRequest) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("UserCreds", err)
 		}
 	}
+	if this.RetryPolicy != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.RetryPolicy); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("RetryPolicy", err)
+		}
+	}
+	if !_regex_CreateUserRequest_CallbackUrl.MatchString(this.CallbackUrl) {
+		return github_com_mwitkow_go_proto_validators.FieldError("CallbackUrl", fmt.Errorf(`value '%v' must be a string conforming to regex "^$|^(?i:https?)://[^/?#\\s]+"`, this.CallbackUrl))
+	}
+	if !(this.TimeoutMs > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("TimeoutMs", fmt.Errorf(`value '%v' must be greater than '-1'`, this.TimeoutMs))
+	}
+	if this.NotBefore != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.NotBefore); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("NotBefore", err)
+		}
+	}
 	return nil
 }
 func (this *CreateUserResponse) Validate() error {
 	return nil
 }
+
+var _regex_DeleteUserRequest_CallbackUrl = regexp.MustCompile(`^$|^(?i:https?)://[^/?#\s]+`)
+
 func (this *DeleteUserRequest) Validate() error {
 	if this.Authn != nil {
 		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Authn); err != nil {
@@ -102,11 +166,30 @@ func (this *DeleteUserRequest) Validate() error {
 	if this.Username == "" {
 		return github_com_mwitkow_go_proto_validators.FieldError("Username", fmt.Errorf(`value '%v' must not be an empty string`, this.Username))
 	}
+	if this.RetryPolicy != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.RetryPolicy); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("RetryPolicy", err)
+		}
+	}
+	if !_regex_DeleteUserRequest_CallbackUrl.MatchString(this.CallbackUrl) {
+		return github_com_mwitkow_go_proto_validators.FieldError("CallbackUrl", fmt.Errorf(`value '%v' must be a string conforming to regex "^$|^(?i:https?)://[^/?#\\s]+"`, this.CallbackUrl))
+	}
+	if !(this.TimeoutMs > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("TimeoutMs", fmt.Errorf(`value '%v' must be greater than '-1'`, this.TimeoutMs))
+	}
+	if this.NotBefore != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.NotBefore); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("NotBefore", err)
+		}
+	}
 	return nil
 }
 func (this *DeleteUserResponse) Validate() error {
 	return nil
 }
+
+var _regex_UpdateUserRequest_CallbackUrl = regexp.MustCompile(`^$|^(?i:https?)://[^/?#\s]+`)
+
 func (this *UpdateUserRequest) Validate() error {
 	if this.Authn != nil {
 		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Authn); err != nil {
@@ -123,11 +206,30 @@ func (this *UpdateUserRequest) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("UserCreds", err)
 		}
 	}
+	if this.RetryPolicy != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.RetryPolicy); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("RetryPolicy", err)
+		}
+	}
+	if !_regex_UpdateUserRequest_CallbackUrl.MatchString(this.CallbackUrl) {
+		return github_com_mwitkow_go_proto_validators.FieldError("CallbackUrl", fmt.Errorf(`value '%v' must be a string conforming to regex "^$|^(?i:https?)://[^/?#\\s]+"`, this.CallbackUrl))
+	}
+	if !(this.TimeoutMs > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("TimeoutMs", fmt.Errorf(`value '%v' must be greater than '-1'`, this.TimeoutMs))
+	}
+	if this.NotBefore != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.NotBefore); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("NotBefore", err)
+		}
+	}
 	return nil
 }
 func (this *UpdateUserResponse) Validate() error {
 	return nil
 }
+
+var _regex_DeactivateSOLRequest_CallbackUrl = regexp.MustCompile(`^$|^(?i:https?)://[^/?#\s]+`)
+
 func (this *DeactivateSOLRequest) Validate() error {
 	if this.Authn != nil {
 		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Authn); err != nil {
@@ -139,8 +241,108 @@ func (this *DeactivateSOLRequest) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("Vendor", err)
 		}
 	}
+	if this.RetryPolicy != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.RetryPolicy); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("RetryPolicy", err)
+		}
+	}
+	if !_regex_DeactivateSOLRequest_CallbackUrl.MatchString(this.CallbackUrl) {
+		return github_com_mwitkow_go_proto_validators.FieldError("CallbackUrl", fmt.Errorf(`value '%v' must be a string conforming to regex "^$|^(?i:https?)://[^/?#\\s]+"`, this.CallbackUrl))
+	}
+	if !(this.TimeoutMs > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("TimeoutMs", fmt.Errorf(`value '%v' must be greater than '-1'`, this.TimeoutMs))
+	}
+	if this.NotBefore != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.NotBefore); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("NotBefore", err)
+		}
+	}
 	return nil
 }
 func (this *DeactivateSOLResponse) Validate() error {
 	return nil
 }
+func (this *NetworkConfig) Validate() error {
+	if _, ok := NetworkSource_name[int32(this.NetworkSource)]; !ok {
+		return github_com_mwitkow_go_proto_validators.FieldError("NetworkSource", fmt.Errorf(`value '%v' must be a valid NetworkSource field`, this.NetworkSource))
+	}
+	if this.Vlan != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Vlan); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Vlan", err)
+		}
+	}
+	return nil
+}
+func (this *NetworkVLAN) Validate() error {
+	if !(this.Id < 4095) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must be less than '4095'`, this.Id))
+	}
+	return nil
+}
+func (this *GetNetworkConfigRequest) Validate() error {
+	if this.Authn != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Authn); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Authn", err)
+		}
+	}
+	if this.Vendor != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Vendor); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Vendor", err)
+		}
+	}
+	return nil
+}
+func (this *GetNetworkConfigResponse) Validate() error {
+	if this.NetworkConfig != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.NetworkConfig); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("NetworkConfig", err)
+		}
+	}
+	return nil
+}
+
+var _regex_SetNetworkConfigRequest_CallbackUrl = regexp.MustCompile(`^$|^(?i:https?)://[^/?#\s]+`)
+
+func (this *SetNetworkConfigRequest) Validate() error {
+	if this.Authn != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Authn); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Authn", err)
+		}
+	}
+	if this.Vendor != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Vendor); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Vendor", err)
+		}
+	}
+	if this.NetworkConfig != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.NetworkConfig); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("NetworkConfig", err)
+		}
+	}
+	if this.RetryPolicy != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.RetryPolicy); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("RetryPolicy", err)
+		}
+	}
+	if !_regex_SetNetworkConfigRequest_CallbackUrl.MatchString(this.CallbackUrl) {
+		return github_com_mwitkow_go_proto_validators.FieldError("CallbackUrl", fmt.Errorf(`value '%v' must be a string conforming to regex "^$|^(?i:https?)://[^/?#\\s]+"`, this.CallbackUrl))
+	}
+	if !(this.TimeoutMs > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("TimeoutMs", fmt.Errorf(`value '%v' must be greater than '-1'`, this.TimeoutMs))
+	}
+	if this.NotBefore != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.NotBefore); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("NotBefore", err)
+		}
+	}
+	if !(this.VerifyTimeoutMs > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("VerifyTimeoutMs", fmt.Errorf(`value '%v' must be greater than '-1'`, this.VerifyTimeoutMs))
+	}
+	if !(this.VerifyTimeoutMs < 3600001) {
+		return github_com_mwitkow_go_proto_validators.FieldError("VerifyTimeoutMs", fmt.Errorf(`value '%v' must be less than '3600001'`, this.VerifyTimeoutMs))
+	}
+	return nil
+}
+func (this *SetNetworkConfigResponse) Validate() error {
+	return nil
+}
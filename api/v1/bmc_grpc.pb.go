@@ -20,12 +20,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	BMC_NetworkSource_FullMethodName = "/github.com.tinkerbell.pbnj.api.v1.BMC/NetworkSource"
-	BMC_Reset_FullMethodName         = "/github.com.tinkerbell.pbnj.api.v1.BMC/Reset"
-	BMC_CreateUser_FullMethodName    = "/github.com.tinkerbell.pbnj.api.v1.BMC/CreateUser"
-	BMC_DeleteUser_FullMethodName    = "/github.com.tinkerbell.pbnj.api.v1.BMC/DeleteUser"
-	BMC_UpdateUser_FullMethodName    = "/github.com.tinkerbell.pbnj.api.v1.BMC/UpdateUser"
-	BMC_DeactivateSOL_FullMethodName = "/github.com.tinkerbell.pbnj.api.v1.BMC/DeactivateSOL"
+	BMC_NetworkSource_FullMethodName    = "/github.com.tinkerbell.pbnj.api.v1.BMC/NetworkSource"
+	BMC_Reset_FullMethodName            = "/github.com.tinkerbell.pbnj.api.v1.BMC/Reset"
+	BMC_CreateUser_FullMethodName       = "/github.com.tinkerbell.pbnj.api.v1.BMC/CreateUser"
+	BMC_DeleteUser_FullMethodName       = "/github.com.tinkerbell.pbnj.api.v1.BMC/DeleteUser"
+	BMC_UpdateUser_FullMethodName       = "/github.com.tinkerbell.pbnj.api.v1.BMC/UpdateUser"
+	BMC_DeactivateSOL_FullMethodName    = "/github.com.tinkerbell.pbnj.api.v1.BMC/DeactivateSOL"
+	BMC_GetNetworkConfig_FullMethodName = "/github.com.tinkerbell.pbnj.api.v1.BMC/GetNetworkConfig"
+	BMC_SetNetworkConfig_FullMethodName = "/github.com.tinkerbell.pbnj.api.v1.BMC/SetNetworkConfig"
 )
 
 // BMCClient is the client API for BMC service.
@@ -38,6 +40,8 @@ type BMCClient interface {
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
 	DeactivateSOL(ctx context.Context, in *DeactivateSOLRequest, opts ...grpc.CallOption) (*DeactivateSOLResponse, error)
+	GetNetworkConfig(ctx context.Context, in *GetNetworkConfigRequest, opts ...grpc.CallOption) (*GetNetworkConfigResponse, error)
+	SetNetworkConfig(ctx context.Context, in *SetNetworkConfigRequest, opts ...grpc.CallOption) (*SetNetworkConfigResponse, error)
 }
 
 type bMCClient struct {
@@ -102,6 +106,24 @@ func (c *bMCClient) DeactivateSOL(ctx context.Context, in *DeactivateSOLRequest,
 	return out, nil
 }
 
+func (c *bMCClient) GetNetworkConfig(ctx context.Context, in *GetNetworkConfigRequest, opts ...grpc.CallOption) (*GetNetworkConfigResponse, error) {
+	out := new(GetNetworkConfigResponse)
+	err := c.cc.Invoke(ctx, BMC_GetNetworkConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bMCClient) SetNetworkConfig(ctx context.Context, in *SetNetworkConfigRequest, opts ...grpc.CallOption) (*SetNetworkConfigResponse, error) {
+	out := new(SetNetworkConfigResponse)
+	err := c.cc.Invoke(ctx, BMC_SetNetworkConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BMCServer is the server API for BMC service.
 // All implementations must embed UnimplementedBMCServer
 // for forward compatibility
@@ -112,6 +134,8 @@ type BMCServer interface {
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
 	DeactivateSOL(context.Context, *DeactivateSOLRequest) (*DeactivateSOLResponse, error)
+	GetNetworkConfig(context.Context, *GetNetworkConfigRequest) (*GetNetworkConfigResponse, error)
+	SetNetworkConfig(context.Context, *SetNetworkConfigRequest) (*SetNetworkConfigResponse, error)
 	mustEmbedUnimplementedBMCServer()
 }
 
@@ -137,6 +161,12 @@ func (UnimplementedBMCServer) UpdateUser(context.Context, *UpdateUserRequest) (*
 func (UnimplementedBMCServer) DeactivateSOL(context.Context, *DeactivateSOLRequest) (*DeactivateSOLResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeactivateSOL not implemented")
 }
+func (UnimplementedBMCServer) GetNetworkConfig(context.Context, *GetNetworkConfigRequest) (*GetNetworkConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkConfig not implemented")
+}
+func (UnimplementedBMCServer) SetNetworkConfig(context.Context, *SetNetworkConfigRequest) (*SetNetworkConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNetworkConfig not implemented")
+}
 func (UnimplementedBMCServer) mustEmbedUnimplementedBMCServer() {}
 
 // UnsafeBMCServer may be embedded to opt out of forward compatibility for this service.
@@ -258,6 +288,42 @@ func _BMC_DeactivateSOL_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BMC_GetNetworkConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetworkConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BMCServer).GetNetworkConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BMC_GetNetworkConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BMCServer).GetNetworkConfig(ctx, req.(*GetNetworkConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BMC_SetNetworkConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNetworkConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BMCServer).SetNetworkConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BMC_SetNetworkConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BMCServer).SetNetworkConfig(ctx, req.(*SetNetworkConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BMC_ServiceDesc is the grpc.ServiceDesc for BMC service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -289,6 +355,14 @@ var BMC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeactivateSOL",
 			Handler:    _BMC_DeactivateSOL_Handler,
 		},
+		{
+			MethodName: "GetNetworkConfig",
+			Handler:    _BMC_GetNetworkConfig_Handler,
+		},
+		{
+			MethodName: "SetNetworkConfig",
+			Handler:    _BMC_SetNetworkConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/bmc.proto",
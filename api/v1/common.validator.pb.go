@@ -58,3 +58,18 @@ func (this *Authn) Validate() error {
 func (this *Vendor) Validate() error {
 	return nil
 }
+func (this *RetryPolicy) Validate() error {
+	if !(this.MaxAttempts > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MaxAttempts", fmt.Errorf(`value '%v' must be greater than '-1'`, this.MaxAttempts))
+	}
+	if !(this.InitialBackoffMs > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("InitialBackoffMs", fmt.Errorf(`value '%v' must be greater than '-1'`, this.InitialBackoffMs))
+	}
+	if !(this.MaxBackoffMs > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("MaxBackoffMs", fmt.Errorf(`value '%v' must be greater than '-1'`, this.MaxBackoffMs))
+	}
+	if !(this.BackoffMultiplier >= 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("BackoffMultiplier", fmt.Errorf(`value '%v' must be greater than or equal to '0'`, this.BackoffMultiplier))
+	}
+	return nil
+}
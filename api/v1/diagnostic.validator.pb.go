@@ -6,10 +6,13 @@ package v1
 import (
 	fmt "fmt"
 	math "math"
+	regexp "regexp"
 
 	proto "github.com/golang/protobuf/proto"
+	_ "github.com/mwitkow/go-proto-validators"
 	github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-validators"
 	_ "google.golang.org/protobuf/types/known/emptypb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -33,6 +36,9 @@ func (this *ScreenshotRequest) Validate() error {
 func (this *ScreenshotResponse) Validate() error {
 	return nil
 }
+
+var _regex_ClearSystemEventLogRequest_CallbackUrl = regexp.MustCompile(`^$|^(?i:https?)://[^/?#\s]+`)
+
 func (this *ClearSystemEventLogRequest) Validate() error {
 	if this.Authn != nil {
 		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Authn); err != nil {
@@ -44,6 +50,22 @@ func (this *ClearSystemEventLogRequest) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("Vendor", err)
 		}
 	}
+	if this.RetryPolicy != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.RetryPolicy); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("RetryPolicy", err)
+		}
+	}
+	if !_regex_ClearSystemEventLogRequest_CallbackUrl.MatchString(this.CallbackUrl) {
+		return github_com_mwitkow_go_proto_validators.FieldError("CallbackUrl", fmt.Errorf(`value '%v' must be a string conforming to regex "^$|^(?i:https?)://[^/?#\\s]+"`, this.CallbackUrl))
+	}
+	if !(this.TimeoutMs > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("TimeoutMs", fmt.Errorf(`value '%v' must be greater than '-1'`, this.TimeoutMs))
+	}
+	if this.NotBefore != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.NotBefore); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("NotBefore", err)
+		}
+	}
 	return nil
 }
 func (this *ClearSystemEventLogResponse) Validate() error {
@@ -6,10 +6,12 @@ package v1
 import (
 	fmt "fmt"
 	math "math"
+	regexp "regexp"
 
 	proto "github.com/golang/protobuf/proto"
 	_ "github.com/mwitkow/go-proto-validators"
 	github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-validators"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -17,6 +19,8 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+var _regex_DeviceRequest_CallbackUrl = regexp.MustCompile(`^$|^(?i:https?)://[^/?#\s]+`)
+
 func (this *DeviceRequest) Validate() error {
 	if this.Authn != nil {
 		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Authn); err != nil {
@@ -31,11 +35,30 @@ func (this *DeviceRequest) Validate() error {
 	if _, ok := BootDevice_name[int32(this.BootDevice)]; !ok {
 		return github_com_mwitkow_go_proto_validators.FieldError("BootDevice", fmt.Errorf(`value '%v' must be a valid BootDevice field`, this.BootDevice))
 	}
+	if this.RetryPolicy != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.RetryPolicy); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("RetryPolicy", err)
+		}
+	}
+	if !_regex_DeviceRequest_CallbackUrl.MatchString(this.CallbackUrl) {
+		return github_com_mwitkow_go_proto_validators.FieldError("CallbackUrl", fmt.Errorf(`value '%v' must be a string conforming to regex "^$|^(?i:https?)://[^/?#\\s]+"`, this.CallbackUrl))
+	}
+	if !(this.TimeoutMs > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("TimeoutMs", fmt.Errorf(`value '%v' must be greater than '-1'`, this.TimeoutMs))
+	}
+	if this.NotBefore != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.NotBefore); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("NotBefore", err)
+		}
+	}
 	return nil
 }
 func (this *DeviceResponse) Validate() error {
 	return nil
 }
+
+var _regex_PowerRequest_CallbackUrl = regexp.MustCompile(`^$|^(?i:https?)://[^/?#\s]+`)
+
 func (this *PowerRequest) Validate() error {
 	if this.Authn != nil {
 		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Authn); err != nil {
@@ -56,8 +79,139 @@ func (this *PowerRequest) Validate() error {
 	if !(this.OffDuration > -1) {
 		return github_com_mwitkow_go_proto_validators.FieldError("OffDuration", fmt.Errorf(`value '%v' must be greater than '-1'`, this.OffDuration))
 	}
+	if this.RetryPolicy != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.RetryPolicy); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("RetryPolicy", err)
+		}
+	}
+	if !_regex_PowerRequest_CallbackUrl.MatchString(this.CallbackUrl) {
+		return github_com_mwitkow_go_proto_validators.FieldError("CallbackUrl", fmt.Errorf(`value '%v' must be a string conforming to regex "^$|^(?i:https?)://[^/?#\\s]+"`, this.CallbackUrl))
+	}
+	if !(this.TimeoutMs > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("TimeoutMs", fmt.Errorf(`value '%v' must be greater than '-1'`, this.TimeoutMs))
+	}
+	if this.NotBefore != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.NotBefore); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("NotBefore", err)
+		}
+	}
 	return nil
 }
 func (this *PowerResponse) Validate() error {
 	return nil
 }
+
+var _regex_WorkflowRequest_CallbackUrl = regexp.MustCompile(`^$|^(?i:https?)://[^/?#\s]+`)
+
+func (this *WorkflowRequest) Validate() error {
+	if this.Authn != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Authn); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Authn", err)
+		}
+	}
+	if this.Vendor != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Vendor); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Vendor", err)
+		}
+	}
+	if len(this.Steps) < 1 {
+		return github_com_mwitkow_go_proto_validators.FieldError("Steps", fmt.Errorf(`value '%v' must contain at least 1 elements`, this.Steps))
+	}
+	for _, item := range this.Steps {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Steps", err)
+			}
+		}
+	}
+	if this.RetryPolicy != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.RetryPolicy); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("RetryPolicy", err)
+		}
+	}
+	if !_regex_WorkflowRequest_CallbackUrl.MatchString(this.CallbackUrl) {
+		return github_com_mwitkow_go_proto_validators.FieldError("CallbackUrl", fmt.Errorf(`value '%v' must be a string conforming to regex "^$|^(?i:https?)://[^/?#\\s]+"`, this.CallbackUrl))
+	}
+	if !(this.TimeoutMs > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("TimeoutMs", fmt.Errorf(`value '%v' must be greater than '-1'`, this.TimeoutMs))
+	}
+	if this.NotBefore != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.NotBefore); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("NotBefore", err)
+		}
+	}
+	return nil
+}
+func (this *WorkflowResponse) Validate() error {
+	return nil
+}
+func (this *WorkflowStep) Validate() error {
+	if this.GetStep() == nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("Step", fmt.Errorf("one of the fields must be set"))
+	}
+	if oneOfNester, ok := this.GetStep().(*WorkflowStep_BootDevice); ok {
+		if oneOfNester.BootDevice != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(oneOfNester.BootDevice); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("BootDevice", err)
+			}
+		}
+	}
+	if oneOfNester, ok := this.GetStep().(*WorkflowStep_Power); ok {
+		if oneOfNester.Power != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(oneOfNester.Power); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Power", err)
+			}
+		}
+	}
+	if oneOfNester, ok := this.GetStep().(*WorkflowStep_Wait); ok {
+		if oneOfNester.Wait != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(oneOfNester.Wait); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Wait", err)
+			}
+		}
+	}
+	if oneOfNester, ok := this.GetStep().(*WorkflowStep_VerifyPower); ok {
+		if oneOfNester.VerifyPower != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(oneOfNester.VerifyPower); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("VerifyPower", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *BootDeviceStep) Validate() error {
+	if _, ok := BootDevice_name[int32(this.BootDevice)]; !ok {
+		return github_com_mwitkow_go_proto_validators.FieldError("BootDevice", fmt.Errorf(`value '%v' must be a valid BootDevice field`, this.BootDevice))
+	}
+	return nil
+}
+func (this *PowerStep) Validate() error {
+	if _, ok := PowerAction_name[int32(this.PowerAction)]; !ok {
+		return github_com_mwitkow_go_proto_validators.FieldError("PowerAction", fmt.Errorf(`value '%v' must be a valid PowerAction field`, this.PowerAction))
+	}
+	if !(this.SoftTimeout > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("SoftTimeout", fmt.Errorf(`value '%v' must be greater than '-1'`, this.SoftTimeout))
+	}
+	if !(this.OffDuration > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("OffDuration", fmt.Errorf(`value '%v' must be greater than '-1'`, this.OffDuration))
+	}
+	return nil
+}
+func (this *WaitStep) Validate() error {
+	if !(this.DurationMs > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("DurationMs", fmt.Errorf(`value '%v' must be greater than '-1'`, this.DurationMs))
+	}
+	return nil
+}
+func (this *VerifyPowerStep) Validate() error {
+	if _, ok := PowerState_name[int32(this.State)]; !ok {
+		return github_com_mwitkow_go_proto_validators.FieldError("State", fmt.Errorf(`value '%v' must be a valid PowerState field`, this.State))
+	}
+	if !(this.TimeoutMs > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("TimeoutMs", fmt.Errorf(`value '%v' must be greater than '-1'`, this.TimeoutMs))
+	}
+	if !(this.PollIntervalMs > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("PollIntervalMs", fmt.Errorf(`value '%v' must be greater than '-1'`, this.PollIntervalMs))
+	}
+	return nil
+}
@@ -22,6 +22,7 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Machine_BootDevice_FullMethodName = "/github.com.tinkerbell.pbnj.api.v1.Machine/BootDevice"
 	Machine_Power_FullMethodName      = "/github.com.tinkerbell.pbnj.api.v1.Machine/Power"
+	Machine_Workflow_FullMethodName   = "/github.com.tinkerbell.pbnj.api.v1.Machine/Workflow"
 )
 
 // MachineClient is the client API for Machine service.
@@ -30,6 +31,7 @@ const (
 type MachineClient interface {
 	BootDevice(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*DeviceResponse, error)
 	Power(ctx context.Context, in *PowerRequest, opts ...grpc.CallOption) (*PowerResponse, error)
+	Workflow(ctx context.Context, in *WorkflowRequest, opts ...grpc.CallOption) (*WorkflowResponse, error)
 }
 
 type machineClient struct {
@@ -58,12 +60,22 @@ func (c *machineClient) Power(ctx context.Context, in *PowerRequest, opts ...grp
 	return out, nil
 }
 
+func (c *machineClient) Workflow(ctx context.Context, in *WorkflowRequest, opts ...grpc.CallOption) (*WorkflowResponse, error) {
+	out := new(WorkflowResponse)
+	err := c.cc.Invoke(ctx, Machine_Workflow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MachineServer is the server API for Machine service.
 // All implementations must embed UnimplementedMachineServer
 // for forward compatibility
 type MachineServer interface {
 	BootDevice(context.Context, *DeviceRequest) (*DeviceResponse, error)
 	Power(context.Context, *PowerRequest) (*PowerResponse, error)
+	Workflow(context.Context, *WorkflowRequest) (*WorkflowResponse, error)
 	mustEmbedUnimplementedMachineServer()
 }
 
@@ -77,6 +89,9 @@ func (UnimplementedMachineServer) BootDevice(context.Context, *DeviceRequest) (*
 func (UnimplementedMachineServer) Power(context.Context, *PowerRequest) (*PowerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Power not implemented")
 }
+func (UnimplementedMachineServer) Workflow(context.Context, *WorkflowRequest) (*WorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Workflow not implemented")
+}
 func (UnimplementedMachineServer) mustEmbedUnimplementedMachineServer() {}
 
 // UnsafeMachineServer may be embedded to opt out of forward compatibility for this service.
@@ -126,6 +141,24 @@ func _Machine_Power_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Machine_Workflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServer).Workflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Machine_Workflow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServer).Workflow(ctx, req.(*WorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Machine_ServiceDesc is the grpc.ServiceDesc for Machine service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -141,6 +174,10 @@ var Machine_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Power",
 			Handler:    _Machine_Power_Handler,
 		},
+		{
+			MethodName: "Workflow",
+			Handler:    _Machine_Workflow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/machine.proto",
@@ -10,6 +10,8 @@ import (
 	proto "github.com/golang/protobuf/proto"
 	_ "github.com/mwitkow/go-proto-validators"
 	github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-validators"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -29,6 +31,195 @@ func (this *StatusResponse) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("Error", err)
 		}
 	}
+	if this.CreatedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.CreatedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("CreatedAt", err)
+		}
+	}
+	if this.StartedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.StartedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("StartedAt", err)
+		}
+	}
+	if this.FinishedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.FinishedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("FinishedAt", err)
+		}
+	}
+	if this.Duration != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Duration); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Duration", err)
+		}
+	}
+	for _, item := range this.StatusMessages {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("StatusMessages", err)
+			}
+		}
+	}
+	if this.TypedResult != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.TypedResult); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("TypedResult", err)
+		}
+	}
+	if this.NotBefore != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.NotBefore); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("NotBefore", err)
+		}
+	}
+	if this.LeaseExpiresAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.LeaseExpiresAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("LeaseExpiresAt", err)
+		}
+	}
+	return nil
+}
+func (this *TaskResult) Validate() error {
+	if oneOfNester, ok := this.GetResult().(*TaskResult_Power); ok {
+		if oneOfNester.Power != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(oneOfNester.Power); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Power", err)
+			}
+		}
+	}
+	if oneOfNester, ok := this.GetResult().(*TaskResult_BootDevice); ok {
+		if oneOfNester.BootDevice != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(oneOfNester.BootDevice); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("BootDevice", err)
+			}
+		}
+	}
+	if oneOfNester, ok := this.GetResult().(*TaskResult_User); ok {
+		if oneOfNester.User != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(oneOfNester.User); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("User", err)
+			}
+		}
+	}
+	if oneOfNester, ok := this.GetResult().(*TaskResult_BmcReset); ok {
+		if oneOfNester.BmcReset != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(oneOfNester.BmcReset); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("BmcReset", err)
+			}
+		}
+	}
+	if oneOfNester, ok := this.GetResult().(*TaskResult_DeactivateSol); ok {
+		if oneOfNester.DeactivateSol != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(oneOfNester.DeactivateSol); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("DeactivateSol", err)
+			}
+		}
+	}
+	if oneOfNester, ok := this.GetResult().(*TaskResult_Workflow); ok {
+		if oneOfNester.Workflow != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(oneOfNester.Workflow); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Workflow", err)
+			}
+		}
+	}
+	if oneOfNester, ok := this.GetResult().(*TaskResult_NetworkSource); ok {
+		if oneOfNester.NetworkSource != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(oneOfNester.NetworkSource); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("NetworkSource", err)
+			}
+		}
+	}
+	if oneOfNester, ok := this.GetResult().(*TaskResult_NetworkConfig); ok {
+		if oneOfNester.NetworkConfig != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(oneOfNester.NetworkConfig); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("NetworkConfig", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *PowerResult) Validate() error {
+	return nil
+}
+func (this *BootDeviceResult) Validate() error {
+	return nil
+}
+func (this *UserResult) Validate() error {
+	return nil
+}
+func (this *BMCResetResult) Validate() error {
+	return nil
+}
+func (this *DeactivateSOLResult) Validate() error {
+	return nil
+}
+func (this *NetworkSourceResult) Validate() error {
+	return nil
+}
+func (this *NetworkConfigResult) Validate() error {
+	if this.NetworkConfig != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.NetworkConfig); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("NetworkConfig", err)
+		}
+	}
+	return nil
+}
+func (this *WorkflowResult) Validate() error {
+	for _, item := range this.Steps {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Steps", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *StatusMessage) Validate() error {
+	if this.Time != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Time); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Time", err)
+		}
+	}
+	return nil
+}
+func (this *WatchRequest) Validate() error {
+	if this.TaskId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("TaskId", fmt.Errorf(`value '%v' must not be an empty string`, this.TaskId))
+	}
+	return nil
+}
+func (this *CancelRequest) Validate() error {
+	if this.TaskId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("TaskId", fmt.Errorf(`value '%v' must not be an empty string`, this.TaskId))
+	}
+	return nil
+}
+func (this *CancelResponse) Validate() error {
+	return nil
+}
+func (this *ListRequest) Validate() error {
+	if _, ok := Completion_name[int32(this.Completion)]; !ok {
+		return github_com_mwitkow_go_proto_validators.FieldError("Completion", fmt.Errorf(`value '%v' must be a valid Completion field`, this.Completion))
+	}
+	if this.CreatedAfter != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.CreatedAfter); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("CreatedAfter", err)
+		}
+	}
+	if this.CreatedBefore != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.CreatedBefore); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("CreatedBefore", err)
+		}
+	}
+	if !(this.PageSize > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("PageSize", fmt.Errorf(`value '%v' must be greater than '-1'`, this.PageSize))
+	}
+	return nil
+}
+func (this *ListResponse) Validate() error {
+	for _, item := range this.Tasks {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Tasks", err)
+			}
+		}
+	}
 	return nil
 }
 func (this *Error) Validate() error {
@@ -21,6 +21,9 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	Task_Status_FullMethodName = "/github.com.tinkerbell.pbnj.api.v1.Task/Status"
+	Task_Cancel_FullMethodName = "/github.com.tinkerbell.pbnj.api.v1.Task/Cancel"
+	Task_List_FullMethodName   = "/github.com.tinkerbell.pbnj.api.v1.Task/List"
+	Task_Watch_FullMethodName  = "/github.com.tinkerbell.pbnj.api.v1.Task/Watch"
 )
 
 // TaskClient is the client API for Task service.
@@ -28,6 +31,14 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TaskClient interface {
 	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	// Cancel stops a scheduled, queued or running task. A task run by another server
+	// stops once that server next renews the task's lease; when servers don't use
+	// leases it can't be told to, and Cancel is Unavailable. Cancelling an unknown task
+	// is NotFound, and cancelling a finished task FailedPrecondition.
+	Cancel(ctx context.Context, in *CancelRequest, opts ...grpc.CallOption) (*CancelResponse, error)
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	// Watch streams the status of a task each time it changes, ending with its final status.
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Task_WatchClient, error)
 }
 
 type taskClient struct {
@@ -47,11 +58,69 @@ func (c *taskClient) Status(ctx context.Context, in *StatusRequest, opts ...grpc
 	return out, nil
 }
 
+func (c *taskClient) Cancel(ctx context.Context, in *CancelRequest, opts ...grpc.CallOption) (*CancelResponse, error) {
+	out := new(CancelResponse)
+	err := c.cc.Invoke(ctx, Task_Cancel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, Task_List_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Task_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Task_ServiceDesc.Streams[0], Task_Watch_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &taskWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Task_WatchClient interface {
+	Recv() (*StatusResponse, error)
+	grpc.ClientStream
+}
+
+type taskWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *taskWatchClient) Recv() (*StatusResponse, error) {
+	m := new(StatusResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // TaskServer is the server API for Task service.
 // All implementations must embed UnimplementedTaskServer
 // for forward compatibility
 type TaskServer interface {
 	Status(context.Context, *StatusRequest) (*StatusResponse, error)
+	// Cancel stops a scheduled, queued or running task. A task run by another server
+	// stops once that server next renews the task's lease; when servers don't use
+	// leases it can't be told to, and Cancel is Unavailable. Cancelling an unknown task
+	// is NotFound, and cancelling a finished task FailedPrecondition.
+	Cancel(context.Context, *CancelRequest) (*CancelResponse, error)
+	List(context.Context, *ListRequest) (*ListResponse, error)
+	// Watch streams the status of a task each time it changes, ending with its final status.
+	Watch(*WatchRequest, Task_WatchServer) error
 	mustEmbedUnimplementedTaskServer()
 }
 
@@ -62,6 +131,15 @@ type UnimplementedTaskServer struct {
 func (UnimplementedTaskServer) Status(context.Context, *StatusRequest) (*StatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
 }
+func (UnimplementedTaskServer) Cancel(context.Context, *CancelRequest) (*CancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
+}
+func (UnimplementedTaskServer) List(context.Context, *ListRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedTaskServer) Watch(*WatchRequest, Task_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
 func (UnimplementedTaskServer) mustEmbedUnimplementedTaskServer() {}
 
 // UnsafeTaskServer may be embedded to opt out of forward compatibility for this service.
@@ -93,6 +171,63 @@ func _Task_Status_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Task_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServer).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Task_Cancel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServer).Cancel(ctx, req.(*CancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Task_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Task_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Task_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaskServer).Watch(m, &taskWatchServer{stream})
+}
+
+type Task_WatchServer interface {
+	Send(*StatusResponse) error
+	grpc.ServerStream
+}
+
+type taskWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *taskWatchServer) Send(m *StatusResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Task_ServiceDesc is the grpc.ServiceDesc for Task service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -104,7 +239,21 @@ var Task_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Status",
 			Handler:    _Task_Status_Handler,
 		},
+		{
+			MethodName: "Cancel",
+			Handler:    _Task_Cancel_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Task_List_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _Task_Watch_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/task.proto",
 }
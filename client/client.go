@@ -2,111 +2,104 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"time"
 
 	v1 "github.com/tinkerbell/pbnj/api/v1"
+	"github.com/tinkerbell/pbnj/pkg/task"
+	"google.golang.org/grpc/metadata"
 )
 
+// WithIdempotencyKey returns a context that sends key as the idempotency key of task requests.
+// A request repeated with the same key, e.g. a retry after a network error, returns the
+// ID of the task the first request started instead of starting another. Keys are scoped
+// to the action and BMC host, so the same key sent with another request starts that request.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, task.IdempotencyKeyHeader, key)
+}
+
 // MachinePower executes a power action against the server and retrieves status.
 func MachinePower(ctx context.Context, client v1.MachineClient, taskClient v1.TaskClient, request *v1.PowerRequest) (*v1.StatusResponse, error) {
-	var statusResp *v1.StatusResponse
 	response, err := client.Power(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	for to := 1; to <= 120; to++ {
-		statusResp, err := taskClient.Status(ctx, &v1.StatusRequest{TaskId: response.TaskId})
-		if err != nil {
-			return nil, err
-		}
-		if statusResp.Complete {
-			return statusResp, nil
-		}
-		time.Sleep(1 * time.Second)
-	}
-	return statusResp, nil
+	return WaitForTask(ctx, taskClient, response.TaskId)
 }
 
 // MachineBootDev sets the next boot device for a machine.
 func MachineBootDev(ctx context.Context, client v1.MachineClient, taskClient v1.TaskClient, request *v1.DeviceRequest) (*v1.StatusResponse, error) {
-	var statusResp *v1.StatusResponse
 	response, err := client.BootDevice(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	for to := 1; to <= 120; to++ {
-		statusResp, err := taskClient.Status(ctx, &v1.StatusRequest{TaskId: response.TaskId})
-		if err != nil {
-			return nil, err
-		}
-		if statusResp.Complete {
-			return statusResp, nil
-		}
-		time.Sleep(1 * time.Second)
+	return WaitForTask(ctx, taskClient, response.TaskId)
+}
+
+// MachineWorkflow runs the steps of a workflow against a machine and retrieves status.
+func MachineWorkflow(ctx context.Context, client v1.MachineClient, taskClient v1.TaskClient, request *v1.WorkflowRequest) (*v1.StatusResponse, error) {
+	response, err := client.Workflow(ctx, request)
+	if err != nil {
+		return nil, err
 	}
-	return statusResp, nil
+	return WaitForTask(ctx, taskClient, response.TaskId)
 }
 
 // BMCCreateUser creates a BMC user.
 func BMCCreateUser(ctx context.Context, client v1.BMCClient, taskClient v1.TaskClient, request *v1.CreateUserRequest) (*v1.StatusResponse, error) {
-	var statusResp *v1.StatusResponse
 	response, err := client.CreateUser(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	for to := 1; to <= 120; to++ {
-		statusResp, err := taskClient.Status(ctx, &v1.StatusRequest{TaskId: response.TaskId})
-		if err != nil {
-			return nil, err
-		}
-		if statusResp.Complete {
-			return statusResp, nil
-		}
-		time.Sleep(1 * time.Second)
-	}
-	return statusResp, nil
+	return WaitForTask(ctx, taskClient, response.TaskId)
 }
 
 // BMCUpdateUser updates a BMC user.
 func BMCUpdateUser(ctx context.Context, client v1.BMCClient, taskClient v1.TaskClient, request *v1.UpdateUserRequest) (*v1.StatusResponse, error) {
-	var statusResp *v1.StatusResponse
 	response, err := client.UpdateUser(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	for to := 1; to <= 120; to++ {
-		statusResp, err := taskClient.Status(ctx, &v1.StatusRequest{TaskId: response.TaskId})
-		if err != nil {
-			return nil, err
-		}
-		if statusResp.Complete {
-			return statusResp, nil
-		}
-		time.Sleep(1 * time.Second)
-	}
-	return statusResp, nil
+	return WaitForTask(ctx, taskClient, response.TaskId)
 }
 
 // BMCDeleteUser updates a BMC user.
 func BMCDeleteUser(ctx context.Context, client v1.BMCClient, taskClient v1.TaskClient, request *v1.DeleteUserRequest) (*v1.StatusResponse, error) {
-	var statusResp *v1.StatusResponse
 	response, err := client.DeleteUser(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	for to := 1; to <= 120; to++ {
-		statusResp, err := taskClient.Status(ctx, &v1.StatusRequest{TaskId: response.TaskId})
-		if err != nil {
-			return nil, err
-		}
-		if statusResp.Complete {
-			return statusResp, nil
-		}
-		time.Sleep(1 * time.Second)
+	return WaitForTask(ctx, taskClient, response.TaskId)
+}
+
+// BMCNetworkSource switches a BMC's network configuration between DHCP and static.
+func BMCNetworkSource(ctx context.Context, client v1.BMCClient, taskClient v1.TaskClient, request *v1.NetworkSourceRequest) (*v1.StatusResponse, error) {
+	response, err := client.NetworkSource(ctx, request)
+	if err != nil {
+		return nil, err
 	}
-	return statusResp, nil
+	return WaitForTask(ctx, taskClient, response.TaskId)
+}
+
+// BMCGetNetworkConfig reads the network configuration of a BMC.
+func BMCGetNetworkConfig(ctx context.Context, client v1.BMCClient, request *v1.GetNetworkConfigRequest) (*v1.NetworkConfig, error) {
+	response, err := client.GetNetworkConfig(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return response.NetworkConfig, nil
+}
+
+// BMCSetNetworkConfig sets the network configuration of a BMC.
+func BMCSetNetworkConfig(ctx context.Context, client v1.BMCClient, taskClient v1.TaskClient, request *v1.SetNetworkConfigRequest) (*v1.StatusResponse, error) {
+	response, err := client.SetNetworkConfig(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return WaitForTask(ctx, taskClient, response.TaskId)
 }
 
 // Screenshot retrieves a screenshot from the server.
@@ -127,27 +120,51 @@ func Screenshot(ctx context.Context, client v1.DiagnosticClient, request *v1.Scr
 
 // ClearSystemEventLog clears the System Event Log of the server.
 func ClearSystemEventLog(ctx context.Context, client v1.DiagnosticClient, taskClient v1.TaskClient, request *v1.ClearSystemEventLogRequest) (*v1.StatusResponse, error) {
-	var statusResp *v1.StatusResponse
 	response, err := client.ClearSystemEventLog(ctx, request)
 	if err != nil {
 		return nil, err
 	}
+	return WaitForTask(ctx, taskClient, response.TaskId)
+}
 
-	for to := 1; to <= 120; to++ {
-		statusResp, err := taskClient.Status(ctx, &v1.StatusRequest{TaskId: response.TaskId})
+// SendNMI will tell the BMC to send an NMI to the server.
+func SendNMI(ctx context.Context, client v1.DiagnosticClient, request *v1.SendNMIRequest) error {
+	_, err := client.SendNMI(ctx, request)
+	return err
+}
+
+// DefaultWaitTimeout is how long WaitForTask, and the helpers that wait for their
+// task, wait for a task to complete when ctx has no deadline.
+const DefaultWaitTimeout = 120 * time.Second
+
+// WaitForTask watches a task until it completes and returns its final status.
+// If the task failed, its error is returned. ctx bounds how long to wait, or
+// DefaultWaitTimeout if it has no deadline.
+func WaitForTask(ctx context.Context, taskClient v1.TaskClient, taskID string) (*v1.StatusResponse, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, DefaultWaitTimeout)
+		defer cancel()
+	}
+	stream, err := taskClient.Watch(ctx, &v1.WatchRequest{TaskId: taskID})
+	if err != nil {
+		return nil, err
+	}
+	var statusResp *v1.StatusResponse
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return statusResp, nil
+		}
 		if err != nil {
 			return nil, err
 		}
-		if statusResp.Complete {
-			return statusResp, nil
-		}
-		time.Sleep(1 * time.Second)
+		statusResp = resp
 	}
-	return statusResp, nil
 }
 
-// SendNMI will tell the BMC to send an NMI to the server.
-func SendNMI(ctx context.Context, client v1.DiagnosticClient, request *v1.SendNMIRequest) error {
-	_, err := client.SendNMI(ctx, request)
+// TaskCancel cancels a running task.
+func TaskCancel(ctx context.Context, taskClient v1.TaskClient, taskID string) error {
+	_, err := taskClient.Cancel(ctx, &v1.CancelRequest{TaskId: taskID})
 	return err
 }
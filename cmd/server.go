@@ -2,7 +2,10 @@ package cmd
 
 import (
 	"context"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"strings"
 	"time"
@@ -18,10 +21,18 @@ import (
 	"github.com/packethost/pkg/grpc/authz"
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
+	v1 "github.com/tinkerbell/pbnj/api/v1"
 	grpcsvr "github.com/tinkerbell/pbnj/grpc"
+	"github.com/tinkerbell/pbnj/grpc/audit"
+	"github.com/tinkerbell/pbnj/grpc/events"
 	"github.com/tinkerbell/pbnj/grpc/oob"
+	"github.com/tinkerbell/pbnj/grpc/persistence"
+	"github.com/tinkerbell/pbnj/grpc/rpc"
+	"github.com/tinkerbell/pbnj/grpc/taskrunner"
 	"github.com/tinkerbell/pbnj/pkg/http"
 	"github.com/tinkerbell/pbnj/pkg/logging"
+	"github.com/tinkerbell/pbnj/pkg/repository"
+	"github.com/tinkerbell/pbnj/pkg/task"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"goa.design/goa/grpc/middleware"
 	"google.golang.org/grpc"
@@ -41,6 +52,8 @@ var (
 
 	// bmcTimeout is the value for how long a BMC call/interaction is allowed to run before it is cancelled.
 	bmcTimeout time.Duration
+	// maxBmcTimeout is the longest timeout for BMC calls a request can ask for.
+	maxBmcTimeout time.Duration
 
 	// When running an action on a BMC, PBnJ will pass the value of the skipRedfishVersions to bmclib
 	// which will then ignore the Redfish endpoint completely on BMCs running the given Redfish versions,
@@ -48,6 +61,73 @@ var (
 	//
 	// for more information see https://github.com/bmc-toolbox/bmclib#bmc-connections
 	skipRedfishVersions string
+
+	// persistenceBackend is the store used for task records: "memory", "bolt" or "redis".
+	// With "memory" all task records are lost when PBnJ restarts.
+	// Use "redis" when multiple PBnJ replicas need to serve the same tasks.
+	persistenceBackend string
+	// dataDir is the directory the "bolt" persistence backend keeps its database file in.
+	dataDir string
+	// redisOpts are the connection details for the "redis" persistence backend.
+	redisOpts persistence.RedisOptions
+
+	// taskRetention and failedTaskRetention are how long completed and failed task records
+	// are kept before they are deleted. Zero keeps them until the store evicts them.
+	taskRetention       time.Duration
+	failedTaskRetention time.Duration
+	taskReapInterval    time.Duration
+
+	// retryPolicy is the default retry policy for BMC tasks, retryableCodes
+	// is the comma separated list of error code names it retries.
+	retryPolicy    task.RetryPolicy
+	retryableCodes string
+
+	// maxWorkers caps the number of BMC tasks running at once. Tasks beyond it are
+	// queued in the order they were received. Zero means no limit.
+	maxWorkers int
+	// maxWorkersPerHost caps the number of tasks running at once against the same BMC.
+	// The default of 1 serializes tasks per BMC, as many mishandle concurrent sessions.
+	maxWorkersPerHost int
+
+	// idempotencyWindow is how long a repeated request with the same idempotency
+	// key returns the task of the first request instead of starting a new one.
+	idempotencyWindow time.Duration
+
+	// replicaID identifies this server as the owner of the tasks it starts, so that
+	// on startup it only aborts or reruns its own incomplete tasks.
+	replicaID string
+	// leaseDuration is how long this replica's claim on a running task lasts unless renewed.
+	// Replicas sharing a persistence backend take over the tasks of replicas whose leases expired.
+	leaseDuration time.Duration
+	// rerunOrphanedTasks runs incomplete tasks that are safe to repeat again on startup.
+	rerunOrphanedTasks bool
+	// taskRequestKeyFile holds the hex encoded key the requests stored with tasks,
+	// which include BMC credentials, are encrypted with.
+	taskRequestKeyFile string
+
+	// webhookURL receives the final status of tasks started without a callback URL,
+	// signed with webhookSecret. webhookRetryPolicy controls retries of all deliveries.
+	webhookURL         string
+	webhookSecret      string
+	webhookRetryPolicy = task.RetryPolicy{Multiplier: 2}
+	// callbackAllowlist are the hosts and CIDRs the callback URLs of requests may point at.
+	callbackAllowlist string
+
+	// eventsURL and eventsFile are the sinks task lifecycle CloudEvents are sent to,
+	// with eventsSource as their source. Events are retried like webhooks.
+	eventsURL    string
+	eventsFile   string
+	eventsSource string
+	// eventsMaxSize and eventsMaxBackups rotate eventsFile like the audit file.
+	eventsMaxSize    int
+	eventsMaxBackups int
+
+	// auditFile is the file mutating RPCs and the outcome of tasks are appended to,
+	// rotated at auditMaxSize megabytes keeping auditMaxBackups old files.
+	auditFile       string
+	auditMaxSize    int
+	auditMaxBackups int
+
 	// serverCmd represents the server command.
 	serverCmd = &cobra.Command{
 		Use:   "server",
@@ -66,14 +146,31 @@ var (
 			authzInterceptor := func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 				return handler(ctx, req)
 			}
+			authzStreamInterceptor := func(srv interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+				return handler(srv, stream)
+			}
 			if enableAuthz {
 				if hsKey != "" || rsPubKey != "" {
-					authzInterceptor = grpc_auth.UnaryServerInterceptor(authFunc())
+					authzInterceptor = grpc_auth.UnaryServerInterceptor(withSubject(authFunc()))
+					authzStreamInterceptor = grpc_auth.StreamServerInterceptor(authFunc())
 				} else {
 					logger.Error(errors.New("error configuring server"), "authorization enabled but no symmetric or asymmetric key was provided")
 					os.Exit(1)
 				}
 			}
+			auditor, closeAudit, err := newAuditor(logger)
+			if err != nil {
+				logger.Error(err, "error configuring audit log")
+				os.Exit(1)
+			}
+			defer closeAudit()
+			auditInterceptor := func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+				return handler(ctx, req)
+			}
+			if auditor != nil {
+				auditInterceptor = auditor.UnaryServerInterceptor()
+			}
+
 			grpc_prometheus.EnableHandlingTimeHistogram()
 			grpcServer := grpc.NewServer(
 				grpc.ChainUnaryInterceptor(
@@ -83,21 +180,90 @@ var (
 					logging.UnaryServerInterceptor(logger),                   // this puts the logger in the context. Allows per-request logging and other middleware to be used.
 					logging.UnaryLogRequestID(requestIDKey, requestIDLogKey), // must be after logging.UnaryServerInterceptor because the logger must be in the context.
 					logging.UnaryLogBMCIP(),                                  // must be after logging.UnaryServerInterceptor because the logger must be in the context.
+					auditInterceptor,                                         // must be after authzInterceptor and logging.UnaryLogRequestID for the caller and request ID.
 					grpc_validator.UnaryServerInterceptor(),
 				),
+				grpc.ChainStreamInterceptor(
+					grpc_prometheus.StreamServerInterceptor,
+					authzStreamInterceptor,
+					middleware.StreamRequestID(middleware.UseXRequestIDMetadataOption(true), middleware.XRequestMetadataLimitOption(512)),
+					logging.StreamServerInterceptor(logger),
+					logging.StreamLogRequestID(requestIDKey, requestIDLogKey),
+					grpc_validator.StreamServerInterceptor(),
+				),
 				grpc.StatsHandler(otelgrpc.NewServerHandler()),
 			)
 
 			httpServer := http.NewServer(metricsAddr)
 			httpServer.WithLogger(logger)
 
-			opts := []grpcsvr.ServerOption{grpcsvr.WithBmcTimeout(bmcTimeout)}
+			opts := []grpcsvr.ServerOption{
+				grpcsvr.WithBmcTimeout(bmcTimeout),
+				grpcsvr.WithMaxBmcTimeout(maxBmcTimeout),
+				grpcsvr.WithTaskRetention(taskRetention, failedTaskRetention),
+				grpcsvr.WithTaskReapInterval(taskReapInterval),
+				grpcsvr.WithMaxWorkers(maxWorkers),
+				grpcsvr.WithMaxWorkersPerHost(maxWorkersPerHost),
+				grpcsvr.WithIdempotencyWindow(idempotencyWindow),
+				grpcsvr.WithRunnerID(replicaID),
+				grpcsvr.WithLeaseDuration(leaseDuration),
+				grpcsvr.WithRerunOrphanedTasks(rerunOrphanedTasks),
+				grpcsvr.WithWebhook(webhookURL, []byte(webhookSecret), webhookRetryPolicy),
+			}
 
 			if skipRedfishVersions != "" {
 				versions := strings.Split(skipRedfishVersions, ",")
 				opts = append(opts, grpcsvr.WithSkipRedfishVersions(versions))
 			}
 
+			if auditor != nil {
+				opts = append(opts, grpcsvr.WithEventSinks(auditor))
+			}
+
+			callbacks, err := rpc.NewCallbackPolicy(strings.Split(callbackAllowlist, ","))
+			if err != nil {
+				logger.Error(err, "error configuring callback allowlist")
+				os.Exit(1)
+			}
+			opts = append(opts, grpcsvr.WithCallbackPolicy(callbacks))
+
+			requestKey, err := taskRequestKey()
+			if err != nil {
+				logger.Error(err, "error configuring task request key")
+				os.Exit(1)
+			}
+			if rerunOrphanedTasks && requestKey == nil {
+				logger.Error(errors.New("rerunOrphanedTasks needs taskRequestKeyFile"), "error configuring task reruns")
+				os.Exit(1)
+			}
+			opts = append(opts, grpcsvr.WithTaskRequestKey(requestKey))
+
+			codes, err := parseCodes(retryableCodes)
+			if err != nil {
+				logger.Error(err, "error configuring retry policy")
+				os.Exit(1)
+			}
+			retryPolicy.RetryableCodes = codes
+			opts = append(opts, grpcsvr.WithRetryPolicy(retryPolicy))
+
+			repo, err := taskRepository(ctx)
+			if err != nil {
+				logger.Error(err, "error configuring persistence", "persistence", persistenceBackend)
+				os.Exit(1)
+			}
+			if repo != nil {
+				defer repo.Close()
+				opts = append(opts, grpcsvr.WithPersistence(repo))
+			}
+
+			sinks, closeSinks, err := eventSinks(ctx, logger)
+			if err != nil {
+				logger.Error(err, "error configuring event sinks")
+				os.Exit(1)
+			}
+			defer closeSinks()
+			opts = append(opts, grpcsvr.WithEventSinks(sinks...))
+
 			if err := grpcsvr.RunServer(ctx, logger, grpcServer, port, httpServer, opts...); err != nil {
 				logger.Error(err, "error running server")
 				os.Exit(1)
@@ -113,10 +279,147 @@ func init() {
 	serverCmd.PersistentFlags().StringVar(&hsKey, "hsKey", "", "HS key")
 	serverCmd.PersistentFlags().StringVar(&rsPubKey, "rsPubKey", "", "RS public key")
 	serverCmd.PersistentFlags().DurationVar(&bmcTimeout, "bmcTimeout", oob.DefaultBMCTimeout, "Timeout for BMC calls")
+	serverCmd.PersistentFlags().DurationVar(&maxBmcTimeout, "maxBmcTimeout", 30*time.Minute, "Longest timeout for BMC calls a request can ask for, 0 means no limit")
 	serverCmd.PersistentFlags().StringVar(&skipRedfishVersions, "skipRedfishVersions", "", "Ignore the redfish endpoint on BMCs running the given version(s)")
+	serverCmd.PersistentFlags().StringVar(&persistenceBackend, "persistence", "memory", "Task persistence backend: memory, bolt or redis")
+	serverCmd.PersistentFlags().StringVar(&dataDir, "dataDir", "/var/lib/pbnj", "Directory for on-disk task persistence")
+	serverCmd.PersistentFlags().DurationVar(&taskRetention, "taskRetention", 24*time.Hour, "How long to keep completed task records, 0 keeps them")
+	serverCmd.PersistentFlags().DurationVar(&failedTaskRetention, "failedTaskRetention", 24*time.Hour, "How long to keep failed task records, 0 keeps them")
+	serverCmd.PersistentFlags().DurationVar(&taskReapInterval, "taskReapInterval", taskrunner.DefaultReapInterval, "How often to delete expired task records")
+	serverCmd.PersistentFlags().IntVar(&retryPolicy.MaxAttempts, "retryMaxAttempts", 1, "Total attempts for a failing BMC task, 1 disables retries")
+	serverCmd.PersistentFlags().DurationVar(&retryPolicy.InitialBackoff, "retryInitialBackoff", time.Second, "Wait before the first retry of a BMC task")
+	serverCmd.PersistentFlags().DurationVar(&retryPolicy.MaxBackoff, "retryMaxBackoff", 30*time.Second, "Maximum wait between retries of a BMC task")
+	serverCmd.PersistentFlags().Float64Var(&retryPolicy.Multiplier, "retryBackoffMultiplier", 2, "Factor the wait between retries grows by")
+	serverCmd.PersistentFlags().StringVar(&retryableCodes, "retryableCodes", "UNKNOWN,UNAVAILABLE,DEADLINE_EXCEEDED", "Comma separated error codes that are retried")
+	serverCmd.PersistentFlags().IntVar(&maxWorkers, "maxWorkers", 0, "Maximum number of BMC tasks running at once, further tasks are queued; 0 means no limit")
+	serverCmd.PersistentFlags().IntVar(&maxWorkersPerHost, "maxWorkersPerHost", 1, "Maximum number of tasks running at once against the same BMC, further tasks are queued; 0 means no limit")
+	serverCmd.PersistentFlags().DurationVar(&idempotencyWindow, "idempotencyWindow", 10*time.Minute, "How long a request's idempotency key returns its task instead of starting a new one; 0 means as long as the task record is kept")
+	hostname, _ := os.Hostname()
+	serverCmd.PersistentFlags().StringVar(&replicaID, "replicaID", hostname, "Stable ID of this replica, used to find its incomplete tasks after a restart")
+	serverCmd.PersistentFlags().DurationVar(&leaseDuration, "leaseDuration", 0, "How long this replica's claim on a running task lasts unless renewed, after which replicas sharing the persistence backend take the task over; 0 disables leases")
+	serverCmd.PersistentFlags().BoolVar(&rerunOrphanedTasks, "rerunOrphanedTasks", false, "Run incomplete tasks that are safe to repeat, such as power status, again after a restart instead of aborting them; needs taskRequestKeyFile to store their requests until they complete")
+	serverCmd.PersistentFlags().StringVar(&taskRequestKeyFile, "taskRequestKeyFile", "", "File holding the hex encoded 32 byte key the requests stored with scheduled and rerun tasks, including credentials, are encrypted with; without it none are stored, so those tasks are aborted by a restart")
+	serverCmd.PersistentFlags().StringVar(&webhookURL, "webhookURL", "", "URL the final status of tasks is POSTed to, unless the request sets a callback URL")
+	serverCmd.PersistentFlags().StringVar(&callbackAllowlist, "callbackAllowlist", "", "Comma separated host names, IPs and CIDRs the callback URLs of requests may point at; empty allows any host not resolving to a loopback or link-local address")
+	serverCmd.PersistentFlags().StringVar(&webhookSecret, "webhookSecret", "", "Secret webhook requests are signed with using HMAC-SHA256, empty sends them unsigned")
+	serverCmd.PersistentFlags().IntVar(&webhookRetryPolicy.MaxAttempts, "webhookMaxAttempts", 5, "Total attempts to deliver a webhook or event")
+	serverCmd.PersistentFlags().DurationVar(&webhookRetryPolicy.InitialBackoff, "webhookInitialBackoff", time.Second, "Wait before the first retry of a webhook or event")
+	serverCmd.PersistentFlags().DurationVar(&webhookRetryPolicy.MaxBackoff, "webhookMaxBackoff", time.Minute, "Maximum wait between retries of a webhook or event")
+	serverCmd.PersistentFlags().StringVar(&eventsURL, "eventsURL", "", "URL task lifecycle CloudEvents are POSTed to")
+	serverCmd.PersistentFlags().StringVar(&eventsFile, "eventsFile", "", "File task lifecycle CloudEvents are appended to as JSON lines")
+	serverCmd.PersistentFlags().IntVar(&eventsMaxSize, "eventsMaxSize", 100, "Size in megabytes at which the events file is rotated")
+	serverCmd.PersistentFlags().IntVar(&eventsMaxBackups, "eventsMaxBackups", 10, "Number of rotated events files kept, 0 keeps them all")
+	serverCmd.PersistentFlags().StringVar(&eventsSource, "eventsSource", "", "Source of task lifecycle CloudEvents, defaults to /pbnj/<replicaID>")
+	serverCmd.PersistentFlags().StringVar(&auditFile, "auditFile", "", "File mutating requests and the outcome of tasks are appended to as JSON lines, with passwords redacted")
+	serverCmd.PersistentFlags().IntVar(&auditMaxSize, "auditMaxSize", 100, "Size in megabytes at which the audit file is rotated")
+	serverCmd.PersistentFlags().IntVar(&auditMaxBackups, "auditMaxBackups", 10, "Number of rotated audit files kept, 0 keeps them all")
+	serverCmd.PersistentFlags().StringVar(&redisOpts.Addr, "redisAddr", "localhost:6379", "Redis server address for redis persistence")
+	serverCmd.PersistentFlags().StringVar(&redisOpts.Username, "redisUsername", "", "Redis ACL username")
+	serverCmd.PersistentFlags().StringVar(&redisOpts.Password, "redisPassword", "", "Redis password")
+	serverCmd.PersistentFlags().IntVar(&redisOpts.DB, "redisDB", 0, "Redis logical database number")
+	serverCmd.PersistentFlags().StringVar(&redisOpts.KeyPrefix, "redisKeyPrefix", persistence.DefaultRedisKeyPrefix, "Prefix for task keys stored in Redis")
+	serverCmd.PersistentFlags().BoolVar(&redisOpts.TLS, "redisTLS", false, "Use TLS when connecting to Redis")
+	serverCmd.PersistentFlags().StringVar(&redisOpts.TLSCAFile, "redisTLSCAFile", "", "PEM CA bundle to verify the Redis server")
+	serverCmd.PersistentFlags().StringVar(&redisOpts.TLSCertFile, "redisTLSCertFile", "", "PEM client certificate for Redis mutual TLS")
+	serverCmd.PersistentFlags().StringVar(&redisOpts.TLSKeyFile, "redisTLSKeyFile", "", "PEM client key for Redis mutual TLS")
+	serverCmd.PersistentFlags().StringVar(&redisOpts.TLSServerName, "redisTLSServerName", "", "Server name used to verify the Redis certificate")
+	serverCmd.PersistentFlags().BoolVar(&redisOpts.TLSInsecureSkipVerify, "redisTLSInsecureSkipVerify", false, "Skip verification of the Redis server certificate")
 	rootCmd.AddCommand(serverCmd)
 }
 
+// closableRepository is a task persistence backend that holds resources which must be released.
+type closableRepository interface {
+	repository.Actions
+	Close() error
+}
+
+// taskRepository returns the task persistence backend selected by the persistence flag.
+// A nil repository means the server's default in-memory store is used.
+func taskRepository(ctx context.Context) (closableRepository, error) {
+	switch persistenceBackend {
+	case "memory":
+		return nil, nil
+	case "bolt":
+		return persistence.NewBolt(dataDir)
+	case "redis":
+		return persistence.NewRedis(ctx, redisOpts)
+	default:
+		return nil, fmt.Errorf("unknown persistence backend: %q", persistenceBackend)
+	}
+}
+
+// eventSinks returns the CloudEvents sinks selected by the events flags,
+// and a func that releases them.
+func eventSinks(ctx context.Context, logger logr.Logger) ([]taskrunner.EventSink, func(), error) {
+	source := eventsSource
+	if source == "" {
+		source = "/pbnj/" + replicaID
+	}
+	var sinks []taskrunner.EventSink
+	closeSinks := func() {}
+	if eventsURL != "" {
+		sinks = append(sinks, events.NewHTTPSink(ctx, logger, eventsURL, source, webhookRetryPolicy))
+	}
+	if eventsFile != "" {
+		f, err := events.NewFileSink(logger, eventsFile, source, int64(eventsMaxSize)<<20, eventsMaxBackups)
+		if err != nil {
+			return nil, nil, err
+		}
+		sinks = append(sinks, f)
+		closeSinks = func() { _ = f.Close() }
+	}
+	return sinks, closeSinks, nil
+}
+
+// newAuditor returns the auditor selected by the audit flags, nil if auditing is off,
+// and a func that releases it.
+func newAuditor(logger logr.Logger) (*audit.Auditor, func(), error) {
+	if auditFile == "" {
+		return nil, func() {}, nil
+	}
+	sink, err := audit.NewFileSink(auditFile, int64(auditMaxSize)<<20, auditMaxBackups)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &audit.Auditor{Sink: sink, Log: logger.WithName("audit")}, func() { _ = sink.Close() }, nil
+}
+
+// taskRequestKey returns the key read from taskRequestKeyFile, nil if it isn't set.
+func taskRequestKey() ([]byte, error) {
+	if taskRequestKeyFile == "" {
+		return nil, nil
+	}
+	b, err := os.ReadFile(taskRequestKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	key, err := hex.DecodeString(strings.TrimSpace(string(b)))
+	if err != nil {
+		return nil, fmt.Errorf("task request key is not hex encoded: %w", err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("task request key is %d bytes, want 32", len(key))
+	}
+	return key, nil
+}
+
+// parseCodes converts a comma separated list of v1.Code names to their values.
+func parseCodes(names string) ([]int32, error) {
+	var codes []int32
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		c, ok := v1.Code_value[strings.ToUpper(name)]
+		if !ok {
+			return nil, fmt.Errorf("unknown error code: %q", name)
+		}
+		codes = append(codes, c)
+	}
+	return codes, nil
+}
+
 // defaultLogger is a zerolog logr implementation.
 func defaultLogger(level string) logr.Logger {
 	zerolog.TimeFieldFormat = zerolog.TimeFormatUnixMs
@@ -171,15 +474,51 @@ func authFunc() func(ctx context.Context) (context.Context, error) {
 		opts = append(opts, authz.WithRSAPubKey(pubKey))
 	}
 
-	protectedMethods := map[string][]string{
-		"/github.com.tinkerbell.pbnj.api.v1.Machine/Power":      {},
-		"/github.com.tinkerbell.pbnj.api.v1.Machine/BootDevice": {},
-		"/github.com.tinkerbell.pbnj.api.v1.BMC/NetworkSource":  {},
-		"/github.com.tinkerbell.pbnj.api.v1.BMC/Reset":          {},
-		"/github.com.tinkerbell.pbnj.api.v1.BMC/CreateUser":     {},
-		"/github.com.tinkerbell.pbnj.api.v1.BMC/DeleteUser":     {},
-		"/github.com.tinkerbell.pbnj.api.v1.BMC/UpdateUser":     {},
-	}
 	config := authz.NewConfig(algo, protectedMethods, opts...)
 	return config.AuthFunc
 }
+
+// protectedMethods are the methods that require a valid JWT when authz is enabled:
+// every method that changes state, i.e. every method that is audited.
+var protectedMethods = func() map[string][]string {
+	methods := make(map[string][]string, len(audit.MutatingMethods))
+	for method := range audit.MutatingMethods {
+		methods[method] = []string{}
+	}
+	return methods
+}()
+
+// withSubject adds the subject of the caller's JWT to the context for the audit log,
+// once authFn has verified it. Tokens are only verified for protected methods, so the
+// subject of any other method is left empty rather than taken from an unverified token.
+// Every audited method is protected, so all of them have the subject.
+func withSubject(authFn grpc_auth.AuthFunc) grpc_auth.AuthFunc {
+	return func(ctx context.Context) (context.Context, error) {
+		ctx, err := authFn(ctx)
+		if err != nil {
+			return ctx, err
+		}
+		method, _ := grpc.Method(ctx)
+		if _, ok := protectedMethods[method]; !ok {
+			return ctx, nil
+		}
+		return audit.WithSubject(ctx, tokenSubject(ctx)), nil
+	}
+}
+
+// tokenSubject returns the subject claim of the bearer token in the incoming metadata, without verifying it.
+func tokenSubject(ctx context.Context) string {
+	raw, err := grpc_auth.AuthFromMD(ctx, "bearer")
+	if err != nil {
+		return ""
+	}
+	token, err := jwt.ParseString(raw)
+	if err != nil {
+		return ""
+	}
+	var claims jwt.StandardClaims
+	if err := json.Unmarshal(token.RawClaims(), &claims); err != nil {
+		return ""
+	}
+	return claims.Subject
+}
@@ -0,0 +1,214 @@
+// Package audit records who asked PBnJ to change what on which BMC, and how it went.
+//
+// Every mutating RPC is written to a Sink as an Entry when it returns, and the
+// outcome of every task is written when the task finishes. Entries of a request
+// and of the task it started share the task ID.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "github.com/tinkerbell/pbnj/api/v1"
+	"github.com/tinkerbell/pbnj/grpc/taskrunner"
+	"github.com/tinkerbell/pbnj/pkg/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Entry kinds.
+const (
+	// KindRequest is the entry of an RPC.
+	KindRequest = "request"
+	// KindTask is the entry of a finished task.
+	KindTask = "task"
+)
+
+// Redacted replaces the value of sensitive request fields in entries.
+const Redacted = "REDACTED"
+
+// MutatingMethods are the RPCs that change the state of a BMC or of PBnJ itself.
+var MutatingMethods = map[string]bool{
+	v1.Machine_BootDevice_FullMethodName:             true,
+	v1.Machine_Power_FullMethodName:                  true,
+	v1.Machine_Workflow_FullMethodName:               true,
+	v1.BMC_NetworkSource_FullMethodName:              true,
+	v1.BMC_Reset_FullMethodName:                      true,
+	v1.BMC_CreateUser_FullMethodName:                 true,
+	v1.BMC_DeleteUser_FullMethodName:                 true,
+	v1.BMC_UpdateUser_FullMethodName:                 true,
+	v1.BMC_DeactivateSOL_FullMethodName:              true,
+	v1.BMC_SetNetworkConfig_FullMethodName:           true,
+	v1.Diagnostic_ClearSystemEventLog_FullMethodName: true,
+	v1.Diagnostic_SendNMI_FullMethodName:             true,
+	v1.Task_Cancel_FullMethodName:                    true,
+}
+
+// Entry is one record of the audit log.
+type Entry struct {
+	Time time.Time `json:"time"`
+	Kind string    `json:"kind"`
+	// Subject is the caller, the subject of their JWT when authorization is enabled.
+	Subject   string `json:"subject,omitempty"`
+	RequestID string `json:"requestId,omitempty"`
+	// Host is the BMC the request or task is for.
+	Host string `json:"host,omitempty"`
+	// Action is the RPC, such as "Machine/Power", for requests and the task description for tasks.
+	Action string `json:"action"`
+	// Params is the request, in protobuf JSON, with passwords redacted.
+	Params json.RawMessage `json:"params,omitempty"`
+	TaskID string          `json:"taskId,omitempty"`
+	// Outcome is the gRPC status code name for requests and "completed" or "failed" for tasks.
+	Outcome string `json:"outcome"`
+	Error   string `json:"error,omitempty"`
+}
+
+// Sink stores audit entries. Write is called concurrently.
+type Sink interface {
+	Write(e Entry) error
+}
+
+// Auditor writes entries to its sink. Entries that can't be written are logged.
+type Auditor struct {
+	Sink Sink
+	Log  logr.Logger
+	// Methods are the full names of the RPCs audited, MutatingMethods when nil.
+	Methods map[string]bool
+}
+
+type subjectKey struct{}
+
+// WithSubject returns a context carrying the authenticated caller of a request.
+func WithSubject(ctx context.Context, subject string) context.Context {
+	return context.WithValue(ctx, subjectKey{}, subject)
+}
+
+// Subject returns the authenticated caller stored by WithSubject.
+func Subject(ctx context.Context) string {
+	s, _ := ctx.Value(subjectKey{}).(string)
+	return s
+}
+
+// UnaryServerInterceptor writes an entry for each audited RPC once it returns.
+// It must run after the interceptors that authenticate the caller and set the request ID.
+func (a *Auditor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		methods := a.Methods
+		if methods == nil {
+			methods = MutatingMethods
+		}
+		if !methods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		resp, err := handler(ctx, req)
+
+		e := Entry{
+			Time:      time.Now().UTC(),
+			Kind:      KindRequest,
+			Subject:   Subject(ctx),
+			RequestID: logging.RequestID(ctx),
+			Action:    action(info.FullMethod),
+			Outcome:   status.Code(err).String(),
+		}
+		if err != nil {
+			e.Error = err.Error()
+		}
+		if r, ok := req.(interface{ GetAuthn() *v1.Authn }); ok {
+			e.Host = r.GetAuthn().GetDirectAuthn().GetHost().GetHost()
+		}
+		if m, ok := req.(proto.Message); ok {
+			e.Params = params(m)
+		}
+		if r, ok := resp.(interface{ GetTaskId() string }); ok {
+			e.TaskID = r.GetTaskId()
+		} else if r, ok := req.(interface{ GetTaskId() string }); ok {
+			e.TaskID = r.GetTaskId()
+		}
+		a.write(e)
+
+		return resp, err
+	}
+}
+
+// Emit writes an entry for each finished task, so that Auditor can be used as a taskrunner.EventSink.
+func (a *Auditor) Emit(ev taskrunner.Event) {
+	if ev.Type != taskrunner.EventCompleted && ev.Type != taskrunner.EventFailed {
+		return
+	}
+	e := Entry{
+		Time:      ev.Time.UTC(),
+		Kind:      KindTask,
+		RequestID: ev.Record.RequestID,
+		Host:      ev.Record.Host,
+		Action:    ev.Record.Description,
+		TaskID:    ev.Record.ID,
+		Outcome:   ev.Type,
+	}
+	if ev.Record.Error != nil {
+		e.Error = ev.Record.Error.Message
+	}
+	a.write(e)
+}
+
+func (a *Auditor) write(e Entry) {
+	if err := a.Sink.Write(e); err != nil {
+		a.Log.Error(err, "unable to write audit entry", "action", e.Action, "taskID", e.TaskID, "requestID", e.RequestID)
+	}
+}
+
+// action returns the service and method of a full RPC name,
+// "Machine/Power" for "/github.com.tinkerbell.pbnj.api.v1.Machine/Power".
+func action(fullMethod string) string {
+	if i := strings.LastIndex(fullMethod, "."); i >= 0 {
+		return fullMethod[i+1:]
+	}
+	return strings.TrimPrefix(fullMethod, "/")
+}
+
+// params returns the request as protobuf JSON with passwords redacted.
+func params(m proto.Message) json.RawMessage {
+	c := proto.Clone(m)
+	redact(c.ProtoReflect())
+	b, err := protojson.Marshal(c)
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+// redact replaces the value of every non-empty string field named like a password, at any depth.
+func redact(m protoreflect.Message) {
+	var sensitive []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Kind() == protoreflect.MessageKind {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					redact(mv.Message())
+					return true
+				})
+			}
+		case fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind:
+			if fd.IsList() {
+				for i := 0; i < v.List().Len(); i++ {
+					redact(v.List().Get(i).Message())
+				}
+			} else {
+				redact(v.Message())
+			}
+		case fd.Kind() == protoreflect.StringKind && !fd.IsList() && strings.Contains(strings.ToLower(string(fd.Name())), "password"):
+			sensitive = append(sensitive, fd)
+		}
+		return true
+	})
+	// fields are set after Range, which must not see the message change.
+	for _, fd := range sensitive {
+		m.Set(fd, protoreflect.ValueOfString(Redacted))
+	}
+}
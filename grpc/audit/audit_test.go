@@ -0,0 +1,196 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	v1 "github.com/tinkerbell/pbnj/api/v1"
+	"github.com/tinkerbell/pbnj/grpc/taskrunner"
+	"github.com/tinkerbell/pbnj/pkg/logging"
+	"github.com/tinkerbell/pbnj/pkg/repository"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type memorySink struct {
+	mu      sync.Mutex
+	entries []Entry
+}
+
+func (m *memorySink) Write(e Entry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = append(m.entries, e)
+	return nil
+}
+
+func authn(password string) *v1.Authn {
+	return &v1.Authn{Authn: &v1.Authn_DirectAuthn{DirectAuthn: &v1.DirectAuthn{
+		Host:     &v1.Host{Host: "10.1.1.1"},
+		Username: "admin",
+		Password: password,
+	}}}
+}
+
+func TestParamsRedactsPasswords(t *testing.T) {
+	in := &v1.CreateUserRequest{
+		Authn:     authn("bmc-secret"),
+		UserCreds: &v1.UserCreds{Username: "ops", Password: "user-secret", UserRole: v1.UserRole_USER_ROLE_ADMIN},
+	}
+	p := string(params(in))
+	if strings.Contains(p, "secret") {
+		t.Fatalf("expected passwords to be redacted, got: %s", p)
+	}
+	if strings.Count(p, Redacted) != 2 || !strings.Contains(p, "ops") || !strings.Contains(p, "10.1.1.1") {
+		t.Fatalf("unexpected params: %s", p)
+	}
+	if in.UserCreds.Password != "user-secret" || in.Authn.GetDirectAuthn().Password != "bmc-secret" {
+		t.Fatal("expected the request not to be changed")
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	testCases := map[string]struct {
+		method  string
+		handler grpc.UnaryHandler
+		want    []Entry
+	}{
+		"audited": {
+			method: v1.Machine_Power_FullMethodName,
+			handler: func(context.Context, interface{}) (interface{}, error) {
+				return &v1.PowerResponse{TaskId: "task1"}, nil
+			},
+			want: []Entry{{
+				Kind: KindRequest, Subject: "alice", RequestID: "req1", Host: "10.1.1.1", Action: "Machine/Power",
+				Params:  json.RawMessage(`{"authn":{"directAuthn":{"host":{"host":"10.1.1.1"},"password":"REDACTED","username":"admin"}},"powerAction":"POWER_ACTION_OFF"}`),
+				TaskID:  "task1",
+				Outcome: "OK",
+			}},
+		},
+		"failed": {
+			method: v1.Machine_Power_FullMethodName,
+			handler: func(context.Context, interface{}) (interface{}, error) {
+				return nil, status.Error(codes.InvalidArgument, "bad request")
+			},
+			want: []Entry{{
+				Kind: KindRequest, Subject: "alice", RequestID: "req1", Host: "10.1.1.1", Action: "Machine/Power",
+				Params:  json.RawMessage(`{"authn":{"directAuthn":{"host":{"host":"10.1.1.1"},"password":"REDACTED","username":"admin"}},"powerAction":"POWER_ACTION_OFF"}`),
+				Outcome: "InvalidArgument",
+				Error:   "rpc error: code = InvalidArgument desc = bad request",
+			}},
+		},
+		"not audited": {
+			method: v1.Task_Status_FullMethodName,
+			handler: func(context.Context, interface{}) (interface{}, error) {
+				return &v1.StatusResponse{}, nil
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sink := &memorySink{}
+			a := &Auditor{Sink: sink, Log: logr.Discard()}
+			info := &grpc.UnaryServerInfo{FullMethod: tc.method}
+			in := &v1.PowerRequest{Authn: authn("bmc-secret"), PowerAction: v1.PowerAction_POWER_ACTION_OFF}
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-request-id", "req1"))
+			ctx = WithSubject(ctx, "alice")
+			_, _ = logging.UnaryLogRequestID("x-request-id", "requestID")(ctx, in, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return a.UnaryServerInterceptor()(ctx, req, info, tc.handler)
+			})
+
+			for i := range sink.entries {
+				if sink.entries[i].Time.IsZero() {
+					t.Fatal("expected entry time to be set")
+				}
+				sink.entries[i].Time = time.Time{}
+				// protojson output is deliberately unstable, so compare it decoded.
+				var p interface{}
+				if err := json.Unmarshal(sink.entries[i].Params, &p); err != nil {
+					t.Fatal(err)
+				}
+				sink.entries[i].Params, _ = json.Marshal(p)
+			}
+			if diff := cmp.Diff(tc.want, sink.entries); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
+
+func TestEmit(t *testing.T) {
+	sink := &memorySink{}
+	a := &Auditor{Sink: sink, Log: logr.Discard()}
+	now := time.Now().UTC()
+	record := repository.Record{ID: "task1", Description: "power action: POWER_ACTION_OFF", Host: "10.1.1.1", RequestID: "req1"}
+	a.Emit(taskrunner.Event{Type: taskrunner.EventStarted, Time: now, Record: record})
+	a.Emit(taskrunner.Event{Type: taskrunner.EventCompleted, Time: now, Record: record})
+	record.Error = &repository.Error{Code: v1.Code_value["UNAVAILABLE"], Message: "bmc unreachable"}
+	a.Emit(taskrunner.Event{Type: taskrunner.EventFailed, Time: now, Record: record})
+
+	task := Entry{Time: now, Kind: KindTask, RequestID: "req1", Host: "10.1.1.1", Action: "power action: POWER_ACTION_OFF", TaskID: "task1"}
+	completed, failed := task, task
+	completed.Outcome = taskrunner.EventCompleted
+	failed.Outcome = taskrunner.EventFailed
+	failed.Error = "bmc unreachable"
+	if diff := cmp.Diff([]Entry{completed, failed}, sink.entries); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestFileSinkRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	line, _ := json.Marshal(Entry{Kind: KindRequest, Action: "Machine/Power", Outcome: "OK"})
+	// room for two entries per file.
+	s, err := NewFileSink(path, int64(2*(len(line)+1)), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	for i := 0; i < 7; i++ {
+		if err := s.Write(Entry{Kind: KindRequest, Action: "Machine/Power", Outcome: "OK"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := map[string]int{path: 1, path + ".1": 2, path + ".2": 2}
+	for p, n := range want {
+		if got := countLines(t, p); got != n {
+			t.Fatalf("expected %v entries in %v, got: %v", n, p, got)
+		}
+	}
+	if _, err := os.Stat(path + ".3"); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected only 2 backups, got: %v", err)
+	}
+}
+
+func countLines(t *testing.T, path string) int {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	n := 0
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			t.Fatal(err)
+		}
+		n++
+	}
+	return n
+}
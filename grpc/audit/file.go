@@ -0,0 +1,42 @@
+package audit
+
+import (
+	"encoding/json"
+
+	"github.com/tinkerbell/pbnj/pkg/logfile"
+)
+
+// DefaultMaxSize is the size in bytes at which a FileSink rotates its file.
+const DefaultMaxSize = logfile.DefaultMaxSize
+
+// FileSink appends entries to a file, one JSON object per line. The file is
+// rotated like a logfile.File.
+type FileSink struct {
+	file *logfile.File
+}
+
+// NewFileSink returns a sink that appends to the file at path, creating it if needed.
+// A maxSize of zero uses DefaultMaxSize, a maxBackups of zero keeps every backup.
+func NewFileSink(path string, maxSize int64, maxBackups int) (*FileSink, error) {
+	f, err := logfile.Open(path, maxSize, maxBackups)
+	if err != nil {
+		return nil, err
+	}
+	return &FileSink{file: f}, nil
+}
+
+// Write appends the entry to the file, rotating it first if it is full.
+// The entry is still written when only the rotation fails.
+func (s *FileSink) Write(e Entry) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	_, err = s.file.Write(append(line, '\n'))
+	return err
+}
+
+// Close closes the file.
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
@@ -0,0 +1,92 @@
+// Package events emits task lifecycle events as CloudEvents.
+package events
+
+import (
+	"time"
+
+	"github.com/rs/xid"
+	"github.com/tinkerbell/pbnj/grpc/taskrunner"
+)
+
+const (
+	// TypePrefix is prepended to the taskrunner event type to form the CloudEvent type,
+	// e.g. "org.tinkerbell.pbnj.task.completed".
+	TypePrefix = "org.tinkerbell.pbnj.task."
+	// ContentType is the media type of a CloudEvent in structured JSON mode.
+	ContentType = "application/cloudevents+json"
+)
+
+// CloudEvent is a CloudEvents 1.0 event in its JSON format.
+type CloudEvent struct {
+	SpecVersion     string    `json:"specversion"`
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	Type            string    `json:"type"`
+	Subject         string    `json:"subject"`
+	Time            time.Time `json:"time"`
+	DataContentType string    `json:"datacontenttype"`
+	Data            TaskData  `json:"data"`
+}
+
+// TaskData is the payload of a task lifecycle CloudEvent.
+type TaskData struct {
+	TaskID string `json:"taskId"`
+	// Action is the description of the task, e.g. "power action: POWER_ACTION_ON".
+	Action string `json:"action"`
+	Host   string `json:"host,omitempty"`
+	// RequestID and TraceID correlate the event with the request and otel trace that started the task.
+	RequestID string   `json:"requestId,omitempty"`
+	TraceID   string   `json:"traceId,omitempty"`
+	State     string   `json:"state"`
+	Attempts  int      `json:"attempts,omitempty"`
+	Message   *Message `json:"message,omitempty"`
+	Result    string   `json:"result,omitempty"`
+	Error     *Error   `json:"error,omitempty"`
+}
+
+// Message is the status message of a "message" event.
+type Message struct {
+	Time     time.Time `json:"time"`
+	Level    string    `json:"level"`
+	Provider string    `json:"provider,omitempty"`
+	Text     string    `json:"text"`
+}
+
+// Error is the error of a "failed" event.
+type Error struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+// NewCloudEvent converts a taskrunner event to a CloudEvent from source.
+func NewCloudEvent(source string, e taskrunner.Event) CloudEvent {
+	rec := e.Record
+	data := TaskData{
+		TaskID:    rec.ID,
+		Action:    rec.Description,
+		Host:      rec.Host,
+		RequestID: rec.RequestID,
+		TraceID:   rec.TraceID,
+		State:     rec.State,
+		Attempts:  rec.Attempts,
+	}
+	if m := e.Message; m != nil {
+		data.Message = &Message{Time: m.Time, Level: m.Level, Provider: m.Provider, Text: m.Text}
+	}
+	if rec.Complete {
+		data.Result = rec.Result
+	}
+	if rec.Failed() {
+		data.Error = &Error{Code: rec.Error.Code, Message: rec.Error.Message}
+	}
+	return CloudEvent{
+		SpecVersion:     "1.0",
+		ID:              xid.New().String(),
+		Source:          source,
+		Type:            TypePrefix + e.Type,
+		Subject:         rec.ID,
+		Time:            e.Time,
+		DataContentType: "application/json",
+		Data:            data,
+	}
+}
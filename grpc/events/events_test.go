@@ -0,0 +1,165 @@
+package events
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "github.com/tinkerbell/pbnj/api/v1"
+	"github.com/tinkerbell/pbnj/grpc/taskrunner"
+	"github.com/tinkerbell/pbnj/pkg/repository"
+	"github.com/tinkerbell/pbnj/pkg/task"
+)
+
+func TestNewCloudEvent(t *testing.T) {
+	now := time.Now().UTC()
+	msg := repository.NewStatusMessage(repository.LevelWarning, "ipmitool", "retrying")
+	testCases := map[string]struct {
+		event taskrunner.Event
+		want  TaskData
+	}{
+		"message": {
+			event: taskrunner.Event{
+				Type:    taskrunner.EventMessage,
+				Record:  repository.Record{ID: "task1", Description: "power action: POWER_ACTION_ON", Host: "10.1.1.1", RequestID: "req1", TraceID: "trace1", State: "running"},
+				Message: &msg,
+			},
+			want: TaskData{
+				TaskID: "task1", Action: "power action: POWER_ACTION_ON", Host: "10.1.1.1", RequestID: "req1", TraceID: "trace1", State: "running",
+				Message: &Message{Time: msg.Time, Level: "warning", Provider: "ipmitool", Text: "retrying"},
+			},
+		},
+		"failed": {
+			event: taskrunner.Event{
+				Type: taskrunner.EventFailed,
+				Record: repository.Record{ID: "task1", State: "complete", Complete: true, Result: "action failed", Attempts: 2,
+					Error: &repository.Error{Code: v1.Code_value["UNAVAILABLE"], Message: "bmc unreachable"}},
+			},
+			want: TaskData{
+				TaskID: "task1", State: "complete", Attempts: 2, Result: "action failed",
+				Error: &Error{Code: v1.Code_value["UNAVAILABLE"], Message: "bmc unreachable"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.event.Time = now
+			ce := NewCloudEvent("/pbnj/test", tc.event)
+			if ce.SpecVersion != "1.0" || ce.ID == "" || ce.Source != "/pbnj/test" || ce.Subject != "task1" || !ce.Time.Equal(now) {
+				t.Fatalf("unexpected CloudEvent attributes: %+v", ce)
+			}
+			if ce.Type != TypePrefix+tc.event.Type {
+				t.Fatalf("expected type %v, got: %v", TypePrefix+tc.event.Type, ce.Type)
+			}
+			got, _ := json.Marshal(ce.Data)
+			want, _ := json.Marshal(tc.want)
+			if string(got) != string(want) {
+				t.Fatalf("expected data %s, got: %s", want, got)
+			}
+		})
+	}
+}
+
+func TestHTTPSink(t *testing.T) {
+	received := make(chan CloudEvent, 10)
+	failures := 1
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Type") != ContentType {
+			t.Errorf("expected content type %v, got: %v", ContentType, r.Header.Get("Content-Type"))
+		}
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var ce CloudEvent
+		if err := json.NewDecoder(r.Body).Decode(&ce); err != nil {
+			t.Error(err)
+		}
+		received <- ce
+	}))
+	defer srv.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sink := NewHTTPSink(ctx, logr.Discard(), srv.URL, "/pbnj/test", task.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond})
+
+	types := []string{taskrunner.EventCreated, taskrunner.EventStarted, taskrunner.EventCompleted}
+	for _, typ := range types {
+		sink.Emit(taskrunner.Event{Type: typ, Time: time.Now(), Record: repository.Record{ID: "task1"}})
+	}
+	for _, typ := range types {
+		select {
+		case ce := <-received:
+			if ce.Type != TypePrefix+typ || ce.Data.TaskID != "task1" {
+				t.Fatalf("expected %v event, got: %+v", typ, ce)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for event")
+		}
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	sink, err := NewFileSink(logr.Discard(), path, "/pbnj/test", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sink.Emit(taskrunner.Event{Type: taskrunner.EventCreated, Time: time.Now(), Record: repository.Record{ID: "task1"}})
+	sink.Emit(taskrunner.Event{Type: taskrunner.EventCompleted, Time: time.Now(), Record: repository.Record{ID: "task1", Complete: true}})
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var types []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var ce CloudEvent
+		if err := json.Unmarshal(scanner.Bytes(), &ce); err != nil {
+			t.Fatal(err)
+		}
+		types = append(types, ce.Type)
+	}
+	if len(types) != 2 || types[0] != TypePrefix+taskrunner.EventCreated || types[1] != TypePrefix+taskrunner.EventCompleted {
+		t.Fatalf("expected a line per event, got: %v", types)
+	}
+}
+
+func TestFileSinkRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	sink, err := NewFileSink(logr.Discard(), path, "/pbnj/test", 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sink.Emit(taskrunner.Event{Type: taskrunner.EventCreated, Time: time.Now(), Record: repository.Record{ID: "task1"}})
+	sink.Emit(taskrunner.Event{Type: taskrunner.EventCompleted, Time: time.Now(), Record: repository.Record{ID: "task1", Complete: true}})
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	for p, typ := range map[string]string{path: taskrunner.EventCompleted, path + ".1": taskrunner.EventCreated} {
+		b, err := os.ReadFile(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ce CloudEvent
+		if err := json.Unmarshal(b, &ce); err != nil {
+			t.Fatal(err)
+		}
+		if ce.Type != TypePrefix+typ {
+			t.Fatalf("expected the %v event in %v, got: %v", typ, p, ce.Type)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	"github.com/tinkerbell/pbnj/grpc/taskrunner"
+	"github.com/tinkerbell/pbnj/pkg/delivery"
+	"github.com/tinkerbell/pbnj/pkg/logfile"
+	"github.com/tinkerbell/pbnj/pkg/metrics"
+	"github.com/tinkerbell/pbnj/pkg/task"
+)
+
+const (
+	// DefaultQueueSize is the number of events an HTTPSink holds while sending.
+	DefaultQueueSize = 1000
+	// DefaultTimeout is how long a single POST of an HTTPSink may take.
+	DefaultTimeout = delivery.DefaultTimeout
+)
+
+// HTTPSink POSTs CloudEvents in structured mode to an HTTP endpoint, one at a time
+// and in the order they were emitted. Sends that fail with a network error, a 429
+// or a 5xx response are retried with backoff. Events emitted while DefaultQueueSize
+// events wait to be sent are dropped.
+type HTTPSink struct {
+	url         string
+	source      string
+	retryPolicy task.RetryPolicy
+	client      *http.Client
+	log         logr.Logger
+	queue       chan CloudEvent
+}
+
+// NewHTTPSink returns a sink that POSTs events from source to url until ctx is done.
+func NewHTTPSink(ctx context.Context, log logr.Logger, url, source string, p task.RetryPolicy) *HTTPSink {
+	s := &HTTPSink{
+		url:         url,
+		source:      source,
+		retryPolicy: p,
+		client:      &http.Client{Timeout: DefaultTimeout},
+		log:         log.WithValues("sink", "http", "url", url),
+		queue:       make(chan CloudEvent, DefaultQueueSize),
+	}
+	go s.run(ctx)
+	return s
+}
+
+// Emit queues the event to be sent.
+func (s *HTTPSink) Emit(e taskrunner.Event) {
+	select {
+	case s.queue <- NewCloudEvent(s.source, e):
+	default:
+		s.log.Info("event queue full, dropping event", "taskID", e.Record.ID, "type", e.Type)
+		metrics.EventsEmitted.WithLabelValues("http", "dropped").Inc()
+	}
+}
+
+func (s *HTTPSink) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ce := <-s.queue:
+			result := "delivered"
+			if err := s.send(ctx, ce); err != nil {
+				s.log.Error(err, "unable to send event", "taskID", ce.Subject, "type", ce.Type)
+				result = "failed"
+			}
+			metrics.EventsEmitted.WithLabelValues("http", result).Inc()
+		}
+	}
+}
+
+func (s *HTTPSink) send(ctx context.Context, ce CloudEvent) error {
+	body, err := json.Marshal(ce)
+	if err != nil {
+		return err
+	}
+	_, err = delivery.Post(ctx, s.client, s.retryPolicy, delivery.Request{URL: s.url, ContentType: ContentType, Body: body}, nil)
+	return err
+}
+
+// FileSink appends CloudEvents to a file, one JSON object per line. The file is
+// rotated like a logfile.File.
+type FileSink struct {
+	source string
+	log    logr.Logger
+	file   *logfile.File
+}
+
+// NewFileSink returns a sink that appends events from source to the file at path, creating it if needed.
+// A maxSize of zero uses logfile.DefaultMaxSize, a maxBackups of zero keeps every backup.
+func NewFileSink(log logr.Logger, path, source string, maxSize int64, maxBackups int) (*FileSink, error) {
+	f, err := logfile.Open(path, maxSize, maxBackups)
+	if err != nil {
+		return nil, err
+	}
+	return &FileSink{source: source, log: log.WithValues("sink", "file", "path", path), file: f}, nil
+}
+
+// Emit writes the event to the file.
+func (s *FileSink) Emit(e taskrunner.Event) {
+	line, err := json.Marshal(NewCloudEvent(s.source, e))
+	if err == nil {
+		_, err = s.file.Write(append(line, '\n'))
+	}
+	if err != nil {
+		s.log.Error(err, "unable to write event", "taskID", e.Record.ID, "type", e.Type)
+		metrics.EventsEmitted.WithLabelValues("file", "failed").Inc()
+		return
+	}
+	metrics.EventsEmitted.WithLabelValues("file", "delivered").Inc()
+}
+
+// Close closes the file.
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/tinkerbell/pbnj/pkg/metrics"
 	"github.com/tinkerbell/pbnj/pkg/oob"
 	"github.com/tinkerbell/pbnj/pkg/repository"
+	"github.com/tinkerbell/pbnj/pkg/task"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
@@ -27,6 +28,11 @@ type Action struct {
 	UpdateUserRequest    *v1.UpdateUserRequest
 	ResetBMCRequest      *v1.ResetRequest
 	DeactivateSOLRequest *v1.DeactivateSOLRequest
+	NetworkSourceRequest *v1.NetworkSourceRequest
+	// GetNetworkConfigRequest and SetNetworkConfigRequest are the requests of the
+	// GetNetworkConfig and SetNetworkConfig actions.
+	GetNetworkConfigRequest *v1.GetNetworkConfigRequest
+	SetNetworkConfigRequest *v1.SetNetworkConfigRequest
 }
 
 // Option to add to an Actions.
@@ -41,7 +47,7 @@ func WithLogger(l logr.Logger) Option {
 }
 
 // WithStatusMessage adds a status message chan to an Action struct.
-func WithStatusMessage(s chan string) Option {
+func WithStatusMessage(s chan repository.StatusMessage) Option {
 	return func(a *Action) error {
 		a.StatusMessages = s
 		return nil
@@ -80,6 +86,30 @@ func WithDeactivateSOLRequest(in *v1.DeactivateSOLRequest) Option {
 	}
 }
 
+// WithNetworkSourceRequest adds a NetworkSourceRequest to the Action.
+func WithNetworkSourceRequest(in *v1.NetworkSourceRequest) Option {
+	return func(a *Action) error {
+		a.NetworkSourceRequest = in
+		return nil
+	}
+}
+
+// WithGetNetworkConfigRequest adds a GetNetworkConfigRequest to the Action.
+func WithGetNetworkConfigRequest(in *v1.GetNetworkConfigRequest) Option {
+	return func(a *Action) error {
+		a.GetNetworkConfigRequest = in
+		return nil
+	}
+}
+
+// WithSetNetworkConfigRequest adds a SetNetworkConfigRequest to the Action.
+func WithSetNetworkConfigRequest(in *v1.SetNetworkConfigRequest) Option {
+	return func(a *Action) error {
+		a.SetNetworkConfigRequest = in
+		return nil
+	}
+}
+
 // WithResetRequest adds ResetRequest to an Action struct.
 func WithResetRequest(in *v1.ResetRequest) Option {
 	return func(a *Action) error {
@@ -156,7 +186,7 @@ func (m Action) setupConnection(ctx context.Context, user, password, host string
 	m.SendStatusMessage("connecting to BMC")
 	successfulConnections, err := common.EstablishConnections(ctx, connections)
 	if err != nil {
-		m.SendStatusMessage("connecting to BMC failed")
+		m.SendStatus(repository.LevelError, "", "connecting to BMC failed")
 		span.SetStatus(codes.Error, "connecting to BMC failed")
 		return nil, err
 	}
@@ -182,7 +212,7 @@ func (m Action) setupConnection(ctx context.Context, user, password, host string
 // noteError will send a GRPC status message, log it, and update
 // the status of the provided tracing span.
 func (m Action) noteError(message string, span trace.Span) {
-	m.SendStatusMessage(message)
+	m.SendStatus(repository.LevelError, "", message)
 	m.Log.Info(message)
 	if span != nil {
 		span.SetStatus(codes.Error, message)
@@ -298,14 +328,14 @@ func (m Action) DeleteUser(ctx context.Context) error {
 }
 
 // BMCReset functionality for machines.
-func (m Action) BMCReset(ctx context.Context, rType string) (err error) {
+func (m Action) BMCReset(ctx context.Context, rType string) (result task.Result, err error) {
 	tracer := otel.Tracer("pbnj")
 	ctx, span := tracer.Start(ctx, "client.BMCReset")
 	defer span.End()
 
 	host, user, password, parseErr := m.ParseAuth(m.ResetBMCRequest.Authn)
 	if parseErr != nil {
-		return parseErr
+		return task.Result{}, parseErr
 	}
 	span.SetAttributes(attribute.String("bmc.host", host), attribute.String("bmc.username", user))
 	m.SendStatusMessage("working on bmc reset")
@@ -325,7 +355,7 @@ func (m Action) BMCReset(ctx context.Context, rType string) (err error) {
 	rLookup, ok := lookup[rType]
 	if !ok {
 		span.SetStatus(codes.Error, "unknown reset request")
-		return &repository.Error{
+		return task.Result{}, &repository.Error{
 			Code:    v1.Code_value["INVALID_ARGUMENT"],
 			Message: "unknown reset request",
 		}
@@ -333,7 +363,7 @@ func (m Action) BMCReset(ctx context.Context, rType string) (err error) {
 	err = client.Open(ctx)
 	if err != nil {
 		span.SetStatus(codes.Error, "Permission Denied: "+err.Error())
-		return &repository.Error{
+		return task.Result{}, &repository.Error{
 			Code:    v1.Code_value["PERMISSION_DENIED"],
 			Message: err.Error(),
 		}
@@ -356,27 +386,27 @@ func (m Action) BMCReset(ctx context.Context, rType string) (err error) {
 	}
 	if err != nil {
 		span.SetStatus(codes.Error, "failed to reset BMC: "+err.Error())
-		m.SendStatusMessage(fmt.Sprintf("failed to %v reset BMC", rLookup))
-		return &repository.Error{
+		m.SendStatus(repository.LevelError, "", fmt.Sprintf("failed to %v reset BMC", rLookup))
+		return task.Result{}, &repository.Error{
 			Code:    v1.Code_value["UNKNOWN"],
 			Message: err.Error(),
 		}
 	}
 	log.Info(fmt.Sprintf("%v reset complete", rLookup))
-	m.SendStatusMessage(fmt.Sprintf("%v bmc reset complete", rLookup))
+	m.SendStatus(repository.LevelInfo, client.GetMetadata().SuccessfulProvider, fmt.Sprintf("%v bmc reset complete", rLookup))
 
-	return nil
+	return task.Result{Typed: &v1.TaskResult{Result: &v1.TaskResult_BmcReset{BmcReset: &v1.BMCResetResult{ResetKind: m.ResetBMCRequest.GetResetKind()}}}}, nil
 }
 
 // DeactivateSOL deactivates a serial-over-LAN session on the device.
-func (m Action) DeactivateSOL(ctx context.Context) error {
+func (m Action) DeactivateSOL(ctx context.Context) (task.Result, error) {
 	tracer := otel.Tracer("pbnj")
 	ctx, span := tracer.Start(ctx, "client.DeactivateSOL")
 	defer span.End()
 
 	host, user, password, parseErr := m.ParseAuth(m.DeactivateSOLRequest.Authn)
 	if parseErr != nil {
-		return parseErr
+		return task.Result{}, parseErr
 	}
 	span.SetAttributes(attribute.String("bmc.host", host), attribute.String("bmc.username", user))
 	m.SendStatusMessage("working on SOL session deactivation")
@@ -391,7 +421,7 @@ func (m Action) DeactivateSOL(ctx context.Context) error {
 
 	if err := client.Open(ctx); err != nil {
 		span.SetStatus(codes.Error, "permission denied: "+err.Error())
-		return &repository.Error{
+		return task.Result{}, &repository.Error{
 			Code:    v1.Code_value["PERMISSION_DENIED"],
 			Message: err.Error(),
 		}
@@ -410,16 +440,21 @@ func (m Action) DeactivateSOL(ctx context.Context) error {
 	if err != nil {
 		span.SetStatus(codes.Error, "failed to deactivate SOL session: "+err.Error())
 		log.Error(err, "failed to deactivate SOL session")
-		m.SendStatusMessage("failed to deactivate SOL session")
-		return &repository.Error{
+		m.SendStatus(repository.LevelError, "", "failed to deactivate SOL session")
+		return task.Result{}, &repository.Error{
 			Code:    v1.Code_value["UNKNOWN"],
 			Message: err.Error(),
 		}
 	}
 	log.Info("SOL deactivation complete")
-	m.SendStatusMessage("SOL deactivation complete")
+	m.SendStatus(repository.LevelInfo, client.GetMetadata().SuccessfulProvider, "SOL deactivation complete")
 
-	return nil
+	return task.Result{Typed: &v1.TaskResult{Result: &v1.TaskResult_DeactivateSol{DeactivateSol: &v1.DeactivateSOLResult{}}}}, nil
+}
+
+// UserResult returns the typed result of a user management task.
+func UserResult(username string, op v1.UserResult_Operation) task.Result {
+	return task.Result{Typed: &v1.TaskResult{Result: &v1.TaskResult_User{User: &v1.UserResult{Username: username, Operation: op}}}}
 }
 
 func logMetadata(md bmc.Metadata) []interface{} {
@@ -0,0 +1,134 @@
+package bmc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/prometheus/client_golang/prometheus"
+	v1 "github.com/tinkerbell/pbnj/api/v1"
+	common "github.com/tinkerbell/pbnj/grpc/oob"
+	"github.com/tinkerbell/pbnj/pkg/metrics"
+	"github.com/tinkerbell/pbnj/pkg/oob"
+	"github.com/tinkerbell/pbnj/pkg/repository"
+	"github.com/tinkerbell/pbnj/pkg/task"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// networkProviders are the names of the ways of managing the network configuration
+// of a BMC, in the order they are tried.
+var networkProviders = []string{"redfish", "ipmitool"}
+
+// setupNetworkConnection connects to the BMC, returning the ways of setting its network
+// source in the order they are tried.
+func (m Action) setupNetworkConnection(ctx context.Context, user, password, host string, source v1.NetworkSource) ([]oob.NetworkSourceSetter, error) {
+	connections := map[string]interface{}{
+		"redfish": &redfishNetwork{
+			host:                host,
+			password:            password,
+			skipRedfishVersions: m.SkipRedfishVersions,
+			source:              source,
+			user:                user,
+		},
+		"ipmitool": &ipmitoolNetwork{
+			channel:  m.NetworkSourceRequest.GetIpmiLanChannel(),
+			host:     host,
+			log:      m.Log,
+			password: password,
+			source:   source,
+			user:     user,
+		},
+	}
+
+	span := trace.SpanFromContext(ctx)
+	m.SendStatusMessage("connecting to BMC")
+	successfulConnections, err := common.EstablishConnections(ctx, connections)
+	if err != nil {
+		m.SendStatus(repository.LevelError, "", "connecting to BMC failed")
+		span.SetStatus(codes.Error, "connecting to BMC failed")
+		return nil, err
+	}
+	m.SendStatusMessage("connected to BMC")
+
+	var setters []oob.NetworkSourceSetter
+	for _, name := range networkProviders {
+		if !slices.Contains(successfulConnections, name) {
+			continue
+		}
+		if r, ok := connections[name].(oob.NetworkSourceSetter); ok {
+			setters = append(setters, r)
+		}
+	}
+	return setters, nil
+}
+
+// NetworkSource switches the BMC's own network configuration between DHCP and static.
+// Redfish is tried first, then IPMI. Switching to static keeps the address the BMC has.
+func (m Action) NetworkSource(ctx context.Context) (task.Result, error) {
+	timer := prometheus.NewTimer(metrics.ActionDuration.With(prometheus.Labels{"service": "bmc", "action": "network_source"}))
+	defer timer.ObserveDuration()
+
+	tracer := otel.Tracer("pbnj")
+	ctx, span := tracer.Start(ctx, "client.NetworkSource")
+	defer span.End()
+
+	host, user, password, err := m.ParseAuth(m.NetworkSourceRequest.Authn)
+	if err != nil {
+		return task.Result{}, err
+	}
+	source := m.NetworkSourceRequest.GetNetworkSource()
+	span.SetAttributes(attribute.String("bmc.host", host), attribute.String("bmc.username", user), attribute.String("bmc.network_source", source.String()))
+
+	if source == v1.NetworkSource_NETWORK_SOURCE_UNSPECIFIED {
+		m.noteError("unknown network source", span)
+		return task.Result{}, &repository.Error{
+			Code:    v1.Code_value["INVALID_ARGUMENT"],
+			Message: "network source must be NETWORK_SOURCE_DHCP or NETWORK_SOURCE_STATIC",
+		}
+	}
+	status := fmt.Sprintf("setting network source to %v", source)
+	m.SendStatusMessage(status)
+
+	setters, err := m.setupNetworkConnection(ctx, user, password, host, source)
+	if err != nil {
+		// setupNetworkConnection is responsible for sending a status message and updating the span
+		return task.Result{}, err
+	}
+	defer func() {
+		for _, s := range setters {
+			if r, ok := s.(common.Connection); ok {
+				r.Close(ctx)
+			}
+		}
+	}()
+
+	if err := oob.SetNetworkSource(ctx, setters); err != nil {
+		m.noteError(fmt.Sprintf("error %s: %v", status, err), span)
+		return task.Result{}, &repository.Error{
+			Code:    v1.Code_value["UNKNOWN"],
+			Message: "setting network source failed",
+			Details: errorDetails(err),
+		}
+	}
+	m.Log.Info("network source set", "host", host, "networkSource", source.String())
+	m.SendStatusMessage(status + " complete")
+	return task.Result{Typed: &v1.TaskResult{Result: &v1.TaskResult_NetworkSource{NetworkSource: &v1.NetworkSourceResult{NetworkSource: source}}}}, nil
+}
+
+// errorDetails returns the messages of the errors err is made of.
+func errorDetails(err error) []string {
+	var merr *multierror.Error
+	if !errors.As(err, &merr) {
+		return []string{err.Error()}
+	}
+	details := make([]string, 0, len(merr.Errors))
+	for _, e := range merr.Errors {
+		details = append(details, e.Error())
+	}
+	return details
+}
@@ -0,0 +1,290 @@
+package bmc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	v1 "github.com/tinkerbell/pbnj/api/v1"
+	"github.com/tinkerbell/pbnj/pkg/metrics"
+	"github.com/tinkerbell/pbnj/pkg/repository"
+	"github.com/tinkerbell/pbnj/pkg/task"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	// defaultVerifyTimeout is the time a BMC has to become reachable at its new address
+	// and show its configuration applied when the request doesn't say.
+	defaultVerifyTimeout = 2 * time.Minute
+	// reachabilityPollInterval is the time between attempts to reach a BMC at its new address.
+	reachabilityPollInterval = 5 * time.Second
+)
+
+// VerifyTimeout returns the time the BMC of a SetNetworkConfig request has to become
+// reachable at its new address and show the configuration applied, on top of the time
+// setting the configuration may take.
+func VerifyTimeout(in *v1.SetNetworkConfigRequest) time.Duration {
+	if t := time.Duration(in.GetVerifyTimeoutMs()) * time.Millisecond; t > 0 {
+		return t
+	}
+	return defaultVerifyTimeout
+}
+
+// GetNetworkConfig reads the configuration of the BMC's own network interface over Redfish.
+func (m Action) GetNetworkConfig(ctx context.Context) (*v1.NetworkConfig, error) {
+	timer := prometheus.NewTimer(metrics.ActionDuration.With(prometheus.Labels{"service": "bmc", "action": "get_network_config"}))
+	defer timer.ObserveDuration()
+
+	tracer := otel.Tracer("pbnj")
+	ctx, span := tracer.Start(ctx, "client.GetNetworkConfig")
+	defer span.End()
+
+	host, user, password, err := m.ParseAuth(m.GetNetworkConfigRequest.GetAuthn())
+	if err != nil {
+		return nil, err
+	}
+	span.SetAttributes(attribute.String("bmc.host", host), attribute.String("bmc.username", user))
+
+	cfg, err := readNetworkConfig(ctx, redfishNetwork{host: host, user: user, password: password, skipRedfishVersions: m.SkipRedfishVersions})
+	if err != nil {
+		m.noteError(fmt.Sprintf("error getting network config: %v", err), span)
+		return nil, &repository.Error{
+			Code:    v1.Code_value["UNKNOWN"],
+			Message: "getting network config failed",
+			Details: []string{err.Error()},
+		}
+	}
+	return cfg, nil
+}
+
+// SetNetworkConfig sets the configuration of the BMC's own network interface over Redfish.
+// It only succeeds once the BMC is reachable at its new address, which is the static address
+// set or, with DHCP, the address it is reached at now or the one it then reports, and the
+// configuration read back there shows the settings given. The result is that configuration.
+func (m Action) SetNetworkConfig(ctx context.Context) (task.Result, error) {
+	timer := prometheus.NewTimer(metrics.ActionDuration.With(prometheus.Labels{"service": "bmc", "action": "set_network_config"}))
+	defer timer.ObserveDuration()
+
+	tracer := otel.Tracer("pbnj")
+	ctx, span := tracer.Start(ctx, "client.SetNetworkConfig")
+	defer span.End()
+
+	host, user, password, err := m.ParseAuth(m.SetNetworkConfigRequest.GetAuthn())
+	if err != nil {
+		return task.Result{}, err
+	}
+	cfg := m.SetNetworkConfigRequest.GetNetworkConfig()
+	span.SetAttributes(attribute.String("bmc.host", host), attribute.String("bmc.username", user), attribute.String("bmc.network_source", cfg.GetNetworkSource().String()))
+
+	if err := validateNetworkConfig(cfg); err != nil {
+		m.noteError(err.Error(), span)
+		return task.Result{}, &repository.Error{
+			Code:    v1.Code_value["INVALID_ARGUMENT"],
+			Message: err.Error(),
+		}
+	}
+
+	m.SendStatusMessage("setting network config")
+	r := &redfishNetwork{host: host, user: user, password: password, skipRedfishVersions: m.SkipRedfishVersions}
+	if err := r.Connect(ctx); err != nil {
+		m.noteError(fmt.Sprintf("error connecting to BMC: %v", err), span)
+		return task.Result{}, err
+	}
+	err = r.SetNetworkConfig(ctx, cfg)
+	r.Close(ctx)
+	if err != nil {
+		m.noteError(fmt.Sprintf("error setting network config: %v", err), span)
+		return task.Result{}, &repository.Error{
+			Code:    v1.Code_value["UNKNOWN"],
+			Message: "setting network config failed",
+			Details: []string{err.Error()},
+		}
+	}
+
+	target := host
+	if cfg.GetNetworkSource() == v1.NetworkSource_NETWORK_SOURCE_STATIC {
+		target = hostWithAddress(host, cfg.GetAddress())
+	}
+	m.SendStatusMessage("network config set, verifying the BMC applied it at " + hostAddress(target))
+	check := networkCheck{conn: redfishNetwork{host: target, iface: r.iface, user: user, password: password, skipRedfishVersions: m.SkipRedfishVersions}, want: cfg}
+	v := m.verifyNetworkConfig(ctx, check, VerifyTimeout(m.SetNetworkConfigRequest))
+	switch {
+	case v.err != nil:
+		m.noteError(fmt.Sprintf("BMC not reachable at %v after setting its network config: %v", hostAddress(v.host), v.err), span)
+		return task.Result{}, &repository.Error{
+			Code:    v1.Code_value["UNAVAILABLE"],
+			Message: fmt.Sprintf("network config set, but the BMC is not reachable at %v", hostAddress(v.host)),
+			Details: []string{v.err.Error()},
+		}
+	case len(v.mismatches) > 0:
+		m.noteError(fmt.Sprintf("BMC at %v did not apply its network config: %v", hostAddress(v.host), strings.Join(v.mismatches, ", ")), span)
+		return task.Result{}, &repository.Error{
+			Code:    v1.Code_value["FAILED_PRECONDITION"],
+			Message: fmt.Sprintf("network config set, but the BMC at %v did not apply it", hostAddress(v.host)),
+			Details: v.mismatches,
+		}
+	}
+	m.Log.Info("network config set", "host", host, "address", hostAddress(v.host))
+	m.SendStatusMessage("BMC applied its network config, reachable at " + hostAddress(v.host))
+	return task.Result{
+		Text:  "network config set, BMC reachable at " + hostAddress(v.host),
+		Typed: &v1.TaskResult{Result: &v1.TaskResult_NetworkConfig{NetworkConfig: &v1.NetworkConfigResult{NetworkConfig: v.got}}},
+	}, nil
+}
+
+// networkCheck is a check that the BMC conn manages applied the configuration want.
+type networkCheck struct {
+	conn redfishNetwork
+	want *v1.NetworkConfig
+}
+
+// networkVerification is the outcome of a networkCheck: the configuration got from the BMC
+// at host, the settings of want it doesn't show, or the error reaching the BMC.
+type networkVerification struct {
+	err        error
+	got        *v1.NetworkConfig
+	host       string
+	mismatches []string
+}
+
+// verifyNetworkConfig runs c until the BMC is reachable and shows the configuration applied,
+// or timeout passes, BMCs taking a while to apply a new configuration. The last outcome is returned.
+func (m Action) verifyNetworkConfig(ctx context.Context, c networkCheck, timeout time.Duration) networkVerification {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for {
+		v := c.run(ctx)
+		if v.err == nil && len(v.mismatches) == 0 {
+			return v
+		}
+		if deadline, _ := ctx.Deadline(); !time.Now().Add(reachabilityPollInterval).Before(deadline) {
+			return v
+		}
+		if v.err != nil {
+			m.Log.V(1).Info("BMC not reachable yet", "host", v.host, "error", v.err.Error())
+		} else {
+			m.Log.V(1).Info("BMC did not apply its network config yet", "host", v.host, "mismatches", v.mismatches)
+		}
+		t := time.NewTimer(reachabilityPollInterval)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return v
+		case <-t.C:
+		}
+	}
+}
+
+// run reads the configuration of the BMC at c.conn.host and compares it with c.want. With DHCP,
+// when the BMC reports an address other than the one it is read at, it is read again there,
+// that being where it will be reachable once it moves to the address it got.
+func (c networkCheck) run(ctx context.Context) networkVerification {
+	got, err := readNetworkConfig(ctx, c.conn)
+	if err != nil {
+		return networkVerification{err: err, host: c.conn.host}
+	}
+	if addr := got.GetAddress(); c.want.GetNetworkSource() == v1.NetworkSource_NETWORK_SOURCE_DHCP && isIPv4(addr) && !net.ParseIP(addr).IsUnspecified() && addr != hostAddress(c.conn.host) {
+		c.conn.host = hostWithAddress(c.conn.host, addr)
+		if got, err = readNetworkConfig(ctx, c.conn); err != nil {
+			return networkVerification{err: err, host: c.conn.host}
+		}
+	}
+	return networkVerification{got: got, host: c.conn.host, mismatches: networkMismatches(c.want, got)}
+}
+
+// readNetworkConfig reads the network configuration of the BMC r manages in a Redfish session of its own.
+func readNetworkConfig(ctx context.Context, r redfishNetwork) (*v1.NetworkConfig, error) {
+	if err := r.Connect(ctx); err != nil {
+		return nil, err
+	}
+	defer r.Close(ctx)
+	return r.NetworkConfig(ctx)
+}
+
+// networkMismatches returns the settings of want that got doesn't show, the ones want
+// leaves unchanged aside.
+func networkMismatches(want, got *v1.NetworkConfig) []string {
+	var mismatches []string
+	mismatch := func(name string, want, got interface{}) {
+		mismatches = append(mismatches, fmt.Sprintf("%v is %v, not %v", name, got, want))
+	}
+	if want.GetNetworkSource() != got.GetNetworkSource() {
+		mismatch("network source", want.GetNetworkSource(), got.GetNetworkSource())
+	}
+	if want.GetNetworkSource() == v1.NetworkSource_NETWORK_SOURCE_STATIC {
+		if want.GetAddress() != got.GetAddress() {
+			mismatch("address", want.GetAddress(), got.GetAddress())
+		}
+		if want.GetNetmask() != got.GetNetmask() {
+			mismatch("netmask", want.GetNetmask(), got.GetNetmask())
+		}
+		if want.GetGateway() != "" && want.GetGateway() != got.GetGateway() {
+			mismatch("gateway", want.GetGateway(), got.GetGateway())
+		}
+	}
+	for _, addr := range want.GetDnsServers() {
+		if !slices.Contains(got.GetDnsServers(), addr) {
+			mismatch("dns servers", want.GetDnsServers(), got.GetDnsServers())
+			break
+		}
+	}
+	if vlan := want.GetVlan(); vlan != nil {
+		if vlan.GetEnabled() != got.GetVlan().GetEnabled() || (vlan.GetEnabled() && vlan.GetId() != got.GetVlan().GetId()) {
+			mismatch("vlan", vlanString(vlan), vlanString(got.GetVlan()))
+		}
+	}
+	if want.GetHostname() != "" && want.GetHostname() != got.GetHostname() {
+		mismatch("hostname", want.GetHostname(), got.GetHostname())
+	}
+	return mismatches
+}
+
+// vlanString returns the VLAN ID of vlan, or "disabled".
+func vlanString(vlan *v1.NetworkVLAN) string {
+	if !vlan.GetEnabled() {
+		return "disabled"
+	}
+	return fmt.Sprint(vlan.GetId())
+}
+
+// validateNetworkConfig checks that cfg can be set: a known network source, IPv4 addresses,
+// for static a required address and netmask, and a VLAN ID when VLAN tagging is enabled.
+func validateNetworkConfig(cfg *v1.NetworkConfig) error {
+	if cfg == nil {
+		return errors.New("network config is required")
+	}
+	switch cfg.GetNetworkSource() {
+	case v1.NetworkSource_NETWORK_SOURCE_DHCP:
+	case v1.NetworkSource_NETWORK_SOURCE_STATIC:
+		if cfg.GetAddress() == "" || cfg.GetNetmask() == "" {
+			return errors.New("address and netmask are required with NETWORK_SOURCE_STATIC")
+		}
+		for _, f := range []struct{ name, addr string }{{"address", cfg.GetAddress()}, {"netmask", cfg.GetNetmask()}, {"gateway", cfg.GetGateway()}} {
+			if f.addr != "" && !isIPv4(f.addr) {
+				return fmt.Errorf("%v %q is not an IPv4 address", f.name, f.addr)
+			}
+		}
+	default:
+		return errors.New("network source must be NETWORK_SOURCE_DHCP or NETWORK_SOURCE_STATIC")
+	}
+	for _, addr := range cfg.GetDnsServers() {
+		if net.ParseIP(addr) == nil {
+			return fmt.Errorf("dns server %q is not an IP address", addr)
+		}
+	}
+	if vlan := cfg.GetVlan(); vlan.GetEnabled() && (vlan.GetId() == 0 || vlan.GetId() > 4094) {
+		return fmt.Errorf("vlan id %v is out of range, it must be between 1 and 4094", vlan.GetId())
+	}
+	return nil
+}
+
+func isIPv4(s string) bool {
+	ip := net.ParseIP(s)
+	return ip != nil && ip.To4() != nil
+}
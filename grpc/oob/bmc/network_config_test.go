@@ -0,0 +1,229 @@
+package bmc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	v1 "github.com/tinkerbell/pbnj/api/v1"
+	"github.com/tinkerbell/pbnj/pkg/repository"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func directAuthn(host string) *v1.Authn {
+	return &v1.Authn{Authn: &v1.Authn_DirectAuthn{DirectAuthn: &v1.DirectAuthn{Host: &v1.Host{Host: host}, Username: "ADMIN", Password: "ADMIN"}}}
+}
+
+func TestGetNetworkConfig(t *testing.T) {
+	srv := newFakeRedfish(t, true)
+	a, err := NewBMCResetter(WithLogger(logr.Discard()), WithGetNetworkConfigRequest(&v1.GetNetworkConfigRequest{Authn: directAuthn(srv.URL)}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := a.GetNetworkConfig(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &v1.NetworkConfig{
+		NetworkSource: v1.NetworkSource_NETWORK_SOURCE_DHCP,
+		Address:       srv.Addr(),
+		Netmask:       "255.255.255.0",
+		Gateway:       "192.0.2.1",
+		Vlan:          &v1.NetworkVLAN{},
+		Hostname:      "bmc-1",
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestSetNetworkConfig(t *testing.T) {
+	srv := newFakeRedfish(t, true)
+	cfg := &v1.NetworkConfig{
+		NetworkSource: v1.NetworkSource_NETWORK_SOURCE_STATIC,
+		Address:       srv.Addr(),
+		Netmask:       "255.255.255.0",
+		Gateway:       "192.0.2.1",
+		DnsServers:    []string{"192.0.2.53"},
+		Vlan:          &v1.NetworkVLAN{Enabled: true, Id: 100},
+		Hostname:      "bmc-2",
+	}
+	a, err := NewBMCResetter(WithLogger(logr.Discard()), WithSetNetworkConfigRequest(&v1.SetNetworkConfigRequest{Authn: directAuthn(srv.URL), NetworkConfig: cfg}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := a.SetNetworkConfig(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []map[string]interface{}{{
+		"DHCPv4":              map[string]interface{}{"DHCPEnabled": false},
+		"IPv4StaticAddresses": []interface{}{map[string]interface{}{"Address": srv.Addr(), "SubnetMask": "255.255.255.0", "Gateway": "192.0.2.1"}},
+		"StaticNameServers":   []interface{}{"192.0.2.53"},
+		"VLAN":                map[string]interface{}{"VLANEnable": true, "VLANId": float64(100)},
+		"HostName":            "bmc-2",
+	}}
+	if diff := cmp.Diff(want, srv.patchesOf(dedicatedNIC)); diff != "" {
+		t.Fatal(diff)
+	}
+	got := result.Typed.GetNetworkConfig().GetNetworkConfig()
+	if got.GetNetworkSource() != v1.NetworkSource_NETWORK_SOURCE_STATIC || got.GetVlan().GetId() != 100 || got.GetHostname() != "bmc-2" {
+		t.Fatalf("expected the config read back from the BMC, got: %v", got)
+	}
+}
+
+func TestSetNetworkConfigPartial(t *testing.T) {
+	srv := newFakeRedfish(t, true)
+	a, err := NewBMCResetter(WithLogger(logr.Discard()), WithSetNetworkConfigRequest(&v1.SetNetworkConfigRequest{
+		Authn: directAuthn(srv.URL),
+		NetworkConfig: &v1.NetworkConfig{
+			NetworkSource: v1.NetworkSource_NETWORK_SOURCE_STATIC,
+			Address:       srv.Addr(),
+			Netmask:       "255.255.255.0",
+		},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := a.SetNetworkConfig(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	// the gateway is kept, and the hostname, DNS servers and VLAN are left alone.
+	want := []map[string]interface{}{{
+		"DHCPv4":              map[string]interface{}{"DHCPEnabled": false},
+		"IPv4StaticAddresses": []interface{}{map[string]interface{}{"Address": srv.Addr(), "SubnetMask": "255.255.255.0", "Gateway": "192.0.2.1"}},
+	}}
+	if diff := cmp.Diff(want, srv.patchesOf(dedicatedNIC)); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestSetNetworkConfigUnreachable(t *testing.T) {
+	srv := newFakeRedfish(t, true)
+	a, err := NewBMCResetter(WithLogger(logr.Discard()), WithSetNetworkConfigRequest(&v1.SetNetworkConfigRequest{
+		Authn: directAuthn(srv.URL),
+		NetworkConfig: &v1.NetworkConfig{
+			NetworkSource: v1.NetworkSource_NETWORK_SOURCE_STATIC,
+			// the BMC is not reachable at its new address, the fake only listens on 127.0.0.1.
+			Address: "192.0.2.20",
+			Netmask: "255.255.255.0",
+		},
+		VerifyTimeoutMs: 200,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = a.SetNetworkConfig(context.Background())
+	var re *repository.Error
+	if !errors.As(err, &re) || re.Code != v1.Code_value["UNAVAILABLE"] {
+		t.Fatalf("expected an unavailable error, got: %v", err)
+	}
+	if len(srv.patchesOf(dedicatedNIC)) != 1 {
+		t.Fatal("expected the config to be set before the BMC was found unreachable")
+	}
+}
+
+func TestSetNetworkConfigNotApplied(t *testing.T) {
+	srv := newFakeRedfish(t, true)
+	srv.ignorePatches = true
+	a, err := NewBMCResetter(WithLogger(logr.Discard()), WithSetNetworkConfigRequest(&v1.SetNetworkConfigRequest{
+		Authn: directAuthn(srv.URL),
+		NetworkConfig: &v1.NetworkConfig{
+			NetworkSource: v1.NetworkSource_NETWORK_SOURCE_STATIC,
+			Address:       srv.Addr(),
+			Netmask:       "255.255.255.0",
+			Hostname:      "bmc-2",
+		},
+		VerifyTimeoutMs: 200,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = a.SetNetworkConfig(context.Background())
+	var re *repository.Error
+	if !errors.As(err, &re) || re.Code != v1.Code_value["FAILED_PRECONDITION"] {
+		t.Fatalf("expected a failed precondition error, got: %v", err)
+	}
+	want := []string{"network source is NETWORK_SOURCE_DHCP, not NETWORK_SOURCE_STATIC", "hostname is bmc-1, not bmc-2"}
+	if diff := cmp.Diff(want, re.Details); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestSetNetworkConfigDHCPAddressChanged(t *testing.T) {
+	srv := newFakeRedfish(t, false)
+	srv.dhcpAddress = "127.0.0.2"
+	srv.listenAt(t, srv.dhcpAddress)
+	a, err := NewBMCResetter(WithLogger(logr.Discard()), WithSetNetworkConfigRequest(&v1.SetNetworkConfigRequest{
+		Authn:         directAuthn(srv.URL),
+		NetworkConfig: &v1.NetworkConfig{NetworkSource: v1.NetworkSource_NETWORK_SOURCE_DHCP},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := a.SetNetworkConfig(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "network config set, BMC reachable at 127.0.0.2"; result.Text != want {
+		t.Fatalf("expected %q, got: %q", want, result.Text)
+	}
+	if got := result.Typed.GetNetworkConfig().GetNetworkConfig(); got.GetNetworkSource() != v1.NetworkSource_NETWORK_SOURCE_DHCP || got.GetAddress() != "127.0.0.2" {
+		t.Fatalf("expected the config read back from the BMC at its new address, got: %v", got)
+	}
+}
+
+func TestValidateNetworkConfig(t *testing.T) {
+	tests := map[string]struct {
+		cfg     *v1.NetworkConfig
+		wantErr string
+	}{
+		"dhcp":                {cfg: &v1.NetworkConfig{NetworkSource: v1.NetworkSource_NETWORK_SOURCE_DHCP, DnsServers: []string{"192.0.2.53"}}},
+		"static":              {cfg: &v1.NetworkConfig{NetworkSource: v1.NetworkSource_NETWORK_SOURCE_STATIC, Address: "192.0.2.10", Netmask: "255.255.255.0", Vlan: &v1.NetworkVLAN{Enabled: true, Id: 4094}}},
+		"nil":                 {wantErr: "network config is required"},
+		"unspecified":         {cfg: &v1.NetworkConfig{}, wantErr: "network source must be NETWORK_SOURCE_DHCP or NETWORK_SOURCE_STATIC"},
+		"static without mask": {cfg: &v1.NetworkConfig{NetworkSource: v1.NetworkSource_NETWORK_SOURCE_STATIC, Address: "192.0.2.10"}, wantErr: "address and netmask are required with NETWORK_SOURCE_STATIC"},
+		"bad gateway":         {cfg: &v1.NetworkConfig{NetworkSource: v1.NetworkSource_NETWORK_SOURCE_STATIC, Address: "192.0.2.10", Netmask: "255.255.255.0", Gateway: "2001:db8::1"}, wantErr: `gateway "2001:db8::1" is not an IPv4 address`},
+		"bad dns server":      {cfg: &v1.NetworkConfig{NetworkSource: v1.NetworkSource_NETWORK_SOURCE_DHCP, DnsServers: []string{"dns"}}, wantErr: `dns server "dns" is not an IP address`},
+		"vlan disabled":       {cfg: &v1.NetworkConfig{NetworkSource: v1.NetworkSource_NETWORK_SOURCE_DHCP, Vlan: &v1.NetworkVLAN{}}},
+		"vlan out of range":   {cfg: &v1.NetworkConfig{NetworkSource: v1.NetworkSource_NETWORK_SOURCE_DHCP, Vlan: &v1.NetworkVLAN{Enabled: true, Id: 4095}}, wantErr: "vlan id 4095 is out of range, it must be between 1 and 4094"},
+		"vlan without id":     {cfg: &v1.NetworkConfig{NetworkSource: v1.NetworkSource_NETWORK_SOURCE_DHCP, Vlan: &v1.NetworkVLAN{Enabled: true}}, wantErr: "vlan id 0 is out of range, it must be between 1 and 4094"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateNetworkConfig(tc.cfg)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.wantErr {
+				t.Fatalf("expected error %q, got: %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestHostWithAddress(t *testing.T) {
+	tests := map[string]struct {
+		host string
+		want string
+	}{
+		"address":      {host: "192.0.2.10", want: "192.0.2.20"},
+		"port":         {host: "192.0.2.10:8443", want: "192.0.2.20:8443"},
+		"url":          {host: "https://192.0.2.10", want: "https://192.0.2.20"},
+		"url and port": {host: "http://bmc.example.com:8000", want: "http://192.0.2.20:8000"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := hostWithAddress(tc.host, "192.0.2.20"); got != tc.want {
+				t.Fatalf("expected %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
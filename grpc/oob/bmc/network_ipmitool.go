@@ -0,0 +1,139 @@
+package bmc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	v1 "github.com/tinkerbell/pbnj/api/v1"
+	"github.com/tinkerbell/pbnj/pkg/repository"
+)
+
+// maxIPMIChannel is the last IPMI channel number a BMC may assign to one of its interfaces,
+// the ones above being reserved.
+const maxIPMIChannel = 11
+
+// ipmitoolNetwork manages the network configuration of a BMC with ipmitool, over IPMI lanplus.
+type ipmitoolNetwork struct {
+	// channel is the IPMI channel of the BMC's LAN interface, found when 0.
+	channel uint32
+	// cipher is the cipher suite the BMC accepted, tried first by run.
+	cipher   string
+	host     string
+	log      logr.Logger
+	password string
+	path     string
+	// source is the network source SetNetworkSource sets.
+	source v1.NetworkSource
+	user   string
+}
+
+// Connect finds ipmitool. IPMI is sessionless, so nothing is opened on the BMC.
+func (i *ipmitoolNetwork) Connect(_ context.Context) error {
+	path, err := exec.LookPath("ipmitool")
+	if err != nil {
+		return &repository.Error{
+			Code:    v1.Code_value["UNKNOWN"],
+			Message: err.Error(),
+		}
+	}
+	i.path = path
+	return nil
+}
+
+// Close is a no-op.
+func (i *ipmitoolNetwork) Close(_ context.Context) {}
+
+// SetNetworkSource sets the address source of the BMC's LAN channel.
+func (i *ipmitoolNetwork) SetNetworkSource(ctx context.Context) error {
+	src := "static"
+	if i.source == v1.NetworkSource_NETWORK_SOURCE_DHCP {
+		src = "dhcp"
+	}
+	channel, err := i.lanChannel(ctx)
+	if err == nil {
+		_, err = i.run(ctx, "lan", "set", channel, "ipsrc", src)
+	}
+	if err != nil {
+		return &repository.Error{
+			Code:    v1.Code_value["UNKNOWN"],
+			Message: err.Error(),
+		}
+	}
+	return nil
+}
+
+// lanChannel returns i.channel, or when it is 0 the first IPMI channel of the BMC
+// whose medium is 802.3 LAN.
+func (i *ipmitoolNetwork) lanChannel(ctx context.Context) (string, error) {
+	if i.channel != 0 {
+		return strconv.Itoa(int(i.channel)), nil
+	}
+	for channel := 1; channel <= maxIPMIChannel; channel++ {
+		out, err := i.run(ctx, "channel", "info", strconv.Itoa(channel))
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		if err == nil && isLANChannel(out) {
+			i.log.V(1).Info("found IPMI LAN channel", "channel", channel)
+			return strconv.Itoa(channel), nil
+		}
+	}
+	return "", errors.New("no IPMI channel with an 802.3 LAN medium found")
+}
+
+// isLANChannel reports whether the output of "ipmitool channel info" is that of an 802.3 LAN channel.
+func isLANChannel(info string) bool {
+	for _, line := range strings.Split(info, "\n") {
+		if k, v, ok := strings.Cut(line, ":"); ok && strings.TrimSpace(k) == "Channel Medium Type" {
+			return strings.TrimSpace(v) == "802.3 LAN"
+		}
+	}
+	return false
+}
+
+// run runs an ipmitool command against the BMC, trying the cipher suites bmclib uses
+// until the BMC accepts one, which is then used first for the commands that follow.
+// The password is passed in the environment rather than on the command line.
+func (i *ipmitoolNetwork) run(ctx context.Context, command ...string) (string, error) {
+	ciphers := []string{"3", "17"}
+	if i.cipher != "" {
+		ciphers = []string{i.cipher}
+	}
+	var out []byte
+	var err error
+	for _, cipher := range ciphers {
+		args := append(ipmitoolArgs(i.host, i.user, cipher), command...)
+		cmd := exec.CommandContext(ctx, i.path, args...)
+		cmd.Env = []string{"IPMITOOL_PASSWORD=" + i.password}
+		out, err = cmd.CombinedOutput()
+		if err == nil {
+			i.cipher = cipher
+			break
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		i.log.V(1).Info("ipmitool command failed", "cipherSuite", cipher, "output", strings.TrimSpace(string(out)))
+	}
+	if err != nil {
+		return string(out), fmt.Errorf("ipmitool %v: %w: %v", strings.Join(command, " "), err, strings.TrimSpace(string(out)))
+	}
+	return string(out), nil
+}
+
+// ipmitoolArgs returns the ipmitool options to reach the BMC at host, which may include a port.
+func ipmitoolArgs(host, user, cipher string) []string {
+	args := []string{"-I", "lanplus", "-U", user, "-E", "-N", "5"}
+	if h, port, err := net.SplitHostPort(host); err == nil {
+		args = append(args, "-H", h, "-p", port)
+	} else {
+		args = append(args, "-H", host)
+	}
+	return append(args, "-C", cipher)
+}
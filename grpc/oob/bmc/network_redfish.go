@@ -0,0 +1,263 @@
+package bmc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+	v1 "github.com/tinkerbell/pbnj/api/v1"
+	"github.com/tinkerbell/pbnj/pkg/repository"
+)
+
+// redfishNetwork manages the network configuration of a BMC through the
+// EthernetInterfaces of its Redfish manager.
+type redfishNetwork struct {
+	client *gofish.APIClient
+	host   string
+	// iface is the @odata.id of the interface managed. When empty it is the one
+	// managerInterface finds, and it is set to it once the configuration is set.
+	iface    string
+	password string
+	// skipRedfishVersions is a list of Redfish versions to be ignored. Connecting
+	// to a BMC running one of them fails, so that other providers are used.
+	skipRedfishVersions []string
+	// source is the network source SetNetworkSource sets.
+	source v1.NetworkSource
+	user   string
+}
+
+// Connect opens a Redfish session with the BMC.
+func (r *redfishNetwork) Connect(ctx context.Context) error {
+	client, err := gofish.ConnectContext(ctx, gofish.ClientConfig{
+		Endpoint: redfishEndpoint(r.host),
+		Username: r.user,
+		Password: r.password,
+		Insecure: true,
+	})
+	if err != nil {
+		return &repository.Error{
+			Code:    v1.Code_value["UNKNOWN"],
+			Message: err.Error(),
+		}
+	}
+	if version := client.Service.RedfishVersion; slices.Contains(r.skipRedfishVersions, version) {
+		client.Logout()
+		return &repository.Error{
+			Code:    v1.Code_value["UNKNOWN"],
+			Message: fmt.Sprintf("redfish version %v is not compatible", version),
+		}
+	}
+	r.client = client
+	return nil
+}
+
+// Close ends the Redfish session.
+func (r *redfishNetwork) Close(_ context.Context) {
+	r.client.Logout()
+}
+
+// SetNetworkSource enables or disables DHCP on the BMC's interface. When DHCP is disabled
+// and the interface has no static address, the address it got from DHCP is made static
+// so that the BMC stays reachable.
+func (r *redfishNetwork) SetNetworkSource(_ context.Context) error {
+	if err := r.setNetworkSource(); err != nil {
+		return &repository.Error{
+			Code:    v1.Code_value["UNKNOWN"],
+			Message: err.Error(),
+		}
+	}
+	return nil
+}
+
+func (r *redfishNetwork) setNetworkSource() error {
+	iface, err := r.managerInterface()
+	if err != nil {
+		return err
+	}
+	dhcp := r.source == v1.NetworkSource_NETWORK_SOURCE_DHCP
+	if iface.DHCPv4.DHCPEnabled == dhcp {
+		return nil
+	}
+	iface.DHCPv4.DHCPEnabled = dhcp
+	if !dhcp && len(iface.IPv4StaticAddresses) == 0 {
+		for _, addr := range iface.IPv4Addresses {
+			iface.IPv4StaticAddresses = append(iface.IPv4StaticAddresses, redfish.IPv4Address{
+				Address:    addr.Address,
+				SubnetMask: addr.SubnetMask,
+				Gateway:    addr.Gateway,
+			})
+		}
+	}
+	return iface.Update()
+}
+
+// NetworkConfig reads the configuration of the BMC's interface.
+func (r *redfishNetwork) NetworkConfig(_ context.Context) (*v1.NetworkConfig, error) {
+	iface, err := r.managerInterface()
+	if err != nil {
+		return nil, err
+	}
+	return networkConfig(iface), nil
+}
+
+// SetNetworkConfig sets the configuration of the BMC's interface. Only the settings cfg
+// gives and that differ from the interface's are sent: an empty gateway keeps the current
+// one, and an empty hostname or list of DNS servers, or no VLAN, leave them as they are.
+func (r *redfishNetwork) SetNetworkConfig(_ context.Context, cfg *v1.NetworkConfig) error {
+	iface, err := r.managerInterface()
+	if err != nil {
+		return err
+	}
+	dhcp := cfg.GetNetworkSource() == v1.NetworkSource_NETWORK_SOURCE_DHCP
+	iface.DHCPv4.DHCPEnabled = dhcp
+	if !dhcp {
+		static := []redfish.IPv4Address{{Address: cfg.GetAddress(), SubnetMask: cfg.GetNetmask(), Gateway: cfg.GetGateway()}}
+		if static[0].Gateway == "" {
+			static[0].Gateway = currentGateway(iface)
+		}
+		if !sameAddresses(iface.IPv4StaticAddresses, static) {
+			iface.IPv4StaticAddresses = static
+		}
+	}
+	if dns := cfg.GetDnsServers(); len(dns) > 0 && !slices.Equal(iface.StaticNameServers, dns) {
+		iface.StaticNameServers = append([]string{}, dns...)
+	}
+	if vlan := cfg.GetVlan(); vlan != nil {
+		iface.VLAN.VLANEnable = vlan.GetEnabled()
+		if vlan.GetEnabled() {
+			iface.VLAN.VLANID = int16(vlan.GetId())
+		}
+	}
+	if hostname := cfg.GetHostname(); hostname != "" {
+		iface.HostName = hostname
+	}
+	if err := iface.Update(); err != nil {
+		return err
+	}
+	r.iface = iface.ODataID
+	return nil
+}
+
+// currentGateway returns the gateway iface uses, its static one if it has any.
+func currentGateway(iface *redfish.EthernetInterface) string {
+	for _, addrs := range [][]redfish.IPv4Address{iface.IPv4StaticAddresses, iface.IPv4Addresses} {
+		for _, a := range addrs {
+			if a.Gateway != "" {
+				return a.Gateway
+			}
+		}
+	}
+	return ""
+}
+
+// networkConfig returns the configuration of iface. Its first IPv4 address is the one reported.
+func networkConfig(iface *redfish.EthernetInterface) *v1.NetworkConfig {
+	cfg := &v1.NetworkConfig{
+		NetworkSource: v1.NetworkSource_NETWORK_SOURCE_STATIC,
+		DnsServers:    iface.NameServers,
+		Vlan:          &v1.NetworkVLAN{Enabled: iface.VLAN.VLANEnable, Id: uint32(iface.VLAN.VLANID)},
+		Hostname:      iface.HostName,
+		MacAddress:    iface.MACAddress,
+	}
+	if iface.DHCPv4.DHCPEnabled {
+		cfg.NetworkSource = v1.NetworkSource_NETWORK_SOURCE_DHCP
+	}
+	if len(iface.IPv4Addresses) > 0 {
+		cfg.Address = iface.IPv4Addresses[0].Address
+		cfg.Netmask = iface.IPv4Addresses[0].SubnetMask
+		cfg.Gateway = iface.IPv4Addresses[0].Gateway
+	}
+	return cfg
+}
+
+// sameAddresses reports whether a and b have the same addresses, netmasks and gateways, in order.
+func sameAddresses(a, b []redfish.IPv4Address) bool {
+	return slices.EqualFunc(a, b, func(x, y redfish.IPv4Address) bool {
+		return x.Address == y.Address && x.SubnetMask == y.SubnetMask && x.Gateway == y.Gateway
+	})
+}
+
+// managerInterface returns the interface of the BMC with the @odata.id r.iface when it is set.
+// Otherwise it is the interface that has the address PBnJ reaches the BMC at, or the first
+// enabled interface of its first manager if none has.
+func (r *redfishNetwork) managerInterface() (*redfish.EthernetInterface, error) {
+	managers, err := r.client.Service.Managers()
+	if err != nil {
+		return nil, err
+	}
+	addr := hostAddress(r.host)
+	var fallback *redfish.EthernetInterface
+	for _, manager := range managers {
+		ifaces, err := manager.EthernetInterfaces()
+		if err != nil {
+			return nil, err
+		}
+		for _, iface := range ifaces {
+			if r.iface != "" {
+				if iface.ODataID == r.iface {
+					return iface, nil
+				}
+				continue
+			}
+			for _, a := range iface.IPv4Addresses {
+				if a.Address == addr {
+					return iface, nil
+				}
+			}
+			if fallback == nil && iface.InterfaceEnabled {
+				fallback = iface
+			}
+		}
+	}
+	if r.iface != "" {
+		return nil, fmt.Errorf("BMC ethernet interface %v not found", r.iface)
+	}
+	if fallback == nil {
+		return nil, errors.New("no enabled BMC ethernet interface found")
+	}
+	return fallback, nil
+}
+
+// redfishEndpoint returns the URL of the Redfish service of the BMC at host,
+// which is a host name or address, optionally with a port, or a URL.
+func redfishEndpoint(host string) string {
+	if strings.HasPrefix(host, "http://") || strings.HasPrefix(host, "https://") {
+		return host
+	}
+	return "https://" + host
+}
+
+// hostWithAddress returns host with its host name or address replaced by addr,
+// keeping the scheme and port it may have.
+func hostWithAddress(host, addr string) string {
+	u, err := url.Parse(redfishEndpoint(host))
+	if err != nil || u.Hostname() == "" {
+		return addr
+	}
+	if port := u.Port(); port != "" {
+		u.Host = net.JoinHostPort(addr, port)
+	} else {
+		u.Host = addr
+	}
+	if redfishEndpoint(host) != host {
+		return u.Host
+	}
+	return u.String()
+}
+
+// hostAddress returns the host name or address of host, without scheme or port.
+func hostAddress(host string) string {
+	if u, err := url.Parse(redfishEndpoint(host)); err == nil && u.Hostname() != "" {
+		return u.Hostname()
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		return h
+	}
+	return host
+}
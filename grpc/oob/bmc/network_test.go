@@ -0,0 +1,323 @@
+package bmc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	v1 "github.com/tinkerbell/pbnj/api/v1"
+	"github.com/tinkerbell/pbnj/pkg/repository"
+)
+
+const (
+	managerInterfaces = "/redfish/v1/Managers/1/EthernetInterfaces"
+	sharedInterface   = managerInterfaces + "/1"
+	dedicatedNIC      = managerInterfaces + "/2"
+)
+
+// fakeRedfish is a Redfish service with one manager that has two ethernet interfaces,
+// the second one holding the address the service is reached at. PATCHes of resources
+// are recorded and, unless ignorePatches is set, merged into them and applied the way
+// a BMC would: the addresses of an interface follow its static ones or, with DHCP,
+// become dhcpAddress when it is set.
+type fakeRedfish struct {
+	*httptest.Server
+	mu            sync.Mutex
+	dhcpAddress   string
+	ignorePatches bool
+	resources     map[string]map[string]interface{}
+	patches       map[string][]map[string]interface{}
+}
+
+func newFakeRedfish(t *testing.T, dhcp bool) *fakeRedfish {
+	t.Helper()
+	f := &fakeRedfish{patches: make(map[string][]map[string]interface{})}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serve))
+	t.Cleanup(f.Close)
+	addr := f.Addr()
+	origin := "Static"
+	if dhcp {
+		origin = "DHCP"
+	}
+	f.resources = map[string]map[string]interface{}{
+		"/redfish/v1": {
+			"@odata.id":      "/redfish/v1",
+			"RedfishVersion": "1.6.0",
+			"Managers":       map[string]interface{}{"@odata.id": "/redfish/v1/Managers"},
+			"Links":          map[string]interface{}{"Sessions": map[string]interface{}{"@odata.id": "/redfish/v1/SessionService/Sessions"}},
+		},
+		"/redfish/v1/Managers": {
+			"Members": []interface{}{map[string]interface{}{"@odata.id": "/redfish/v1/Managers/1"}},
+		},
+		"/redfish/v1/Managers/1": {
+			"@odata.id":          "/redfish/v1/Managers/1",
+			"Id":                 "1",
+			"EthernetInterfaces": map[string]interface{}{"@odata.id": managerInterfaces},
+		},
+		managerInterfaces: {
+			"Members": []interface{}{
+				map[string]interface{}{"@odata.id": sharedInterface},
+				map[string]interface{}{"@odata.id": dedicatedNIC},
+			},
+		},
+		sharedInterface: {
+			"@odata.id":        sharedInterface,
+			"Id":               "1",
+			"InterfaceEnabled": true,
+			"DHCPv4":           map[string]interface{}{"DHCPEnabled": true},
+			"IPv4Addresses":    []interface{}{map[string]interface{}{"Address": "192.0.2.10", "SubnetMask": "255.255.255.0", "AddressOrigin": "DHCP"}},
+		},
+		dedicatedNIC: {
+			"@odata.id":        dedicatedNIC,
+			"Id":               "2",
+			"InterfaceEnabled": true,
+			"HostName":         "bmc-1",
+			"DHCPv4":           map[string]interface{}{"DHCPEnabled": dhcp},
+			"IPv4Addresses":    []interface{}{map[string]interface{}{"Address": addr, "SubnetMask": "255.255.255.0", "Gateway": "192.0.2.1", "AddressOrigin": origin}},
+		},
+	}
+	return f
+}
+
+// Addr returns the host address the service listens on.
+func (f *fakeRedfish) Addr() string {
+	u, _ := url.Parse(f.URL)
+	return u.Hostname()
+}
+
+func (f *fakeRedfish) serve(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	path := strings.TrimRight(r.URL.Path, "/")
+	switch {
+	case r.Method == http.MethodPost && path == "/redfish/v1/SessionService/Sessions":
+		w.Header().Set("X-Auth-Token", "token")
+		w.Header().Set("Location", "/redfish/v1/SessionService/Sessions/1")
+		w.WriteHeader(http.StatusCreated)
+		return
+	case r.Method == http.MethodDelete:
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	res, ok := f.resources[path]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		_ = json.NewEncoder(w).Encode(res)
+	case http.MethodPatch:
+		var patch map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.patches[path] = append(f.patches[path], patch)
+		if !f.ignorePatches {
+			for k, v := range patch {
+				res[k] = v
+			}
+			f.apply(res)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// apply updates the addresses and name servers an interface reports to its settings.
+func (f *fakeRedfish) apply(iface map[string]interface{}) {
+	dhcp, ok := iface["DHCPv4"].(map[string]interface{})["DHCPEnabled"].(bool)
+	if !ok {
+		return
+	}
+	if static, _ := iface["IPv4StaticAddresses"].([]interface{}); !dhcp && len(static) > 0 {
+		addrs := make([]interface{}, 0, len(static))
+		for _, a := range static {
+			addr := map[string]interface{}{"AddressOrigin": "Static"}
+			for k, v := range a.(map[string]interface{}) {
+				addr[k] = v
+			}
+			addrs = append(addrs, addr)
+		}
+		iface["IPv4Addresses"] = addrs
+	}
+	if dhcp && f.dhcpAddress != "" {
+		iface["IPv4Addresses"] = []interface{}{map[string]interface{}{"Address": f.dhcpAddress, "SubnetMask": "255.255.255.0", "Gateway": "192.0.2.1", "AddressOrigin": "DHCP"}}
+	}
+	if ns, ok := iface["StaticNameServers"]; ok {
+		iface["NameServers"] = ns
+	}
+}
+
+// listenAt makes the service reachable at addr too, on the same port.
+func (f *fakeRedfish) listenAt(t *testing.T, addr string) {
+	t.Helper()
+	u, _ := url.Parse(f.URL)
+	l, err := net.Listen("tcp", net.JoinHostPort(addr, u.Port()))
+	if err != nil {
+		t.Skipf("cannot listen at %v: %v", addr, err)
+	}
+	srv := &http.Server{Handler: http.HandlerFunc(f.serve), ReadHeaderTimeout: time.Second}
+	go func() { _ = srv.Serve(l) }()
+	t.Cleanup(func() { srv.Close() })
+}
+
+func (f *fakeRedfish) patchesOf(path string) []map[string]interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.patches[path]
+}
+
+func TestRedfishSetNetworkSource(t *testing.T) {
+	tests := map[string]struct {
+		dhcp   bool
+		source v1.NetworkSource
+		want   []map[string]interface{}
+	}{
+		"dhcp to static keeps the address": {
+			dhcp:   true,
+			source: v1.NetworkSource_NETWORK_SOURCE_STATIC,
+			want: []map[string]interface{}{{
+				"DHCPv4":              map[string]interface{}{"DHCPEnabled": false},
+				"IPv4StaticAddresses": []interface{}{map[string]interface{}{"Address": "127.0.0.1", "SubnetMask": "255.255.255.0", "Gateway": "192.0.2.1"}},
+			}},
+		},
+		"static to dhcp": {
+			source: v1.NetworkSource_NETWORK_SOURCE_DHCP,
+			want:   []map[string]interface{}{{"DHCPv4": map[string]interface{}{"DHCPEnabled": true}}},
+		},
+		"already dhcp": {
+			dhcp:   true,
+			source: v1.NetworkSource_NETWORK_SOURCE_DHCP,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := newFakeRedfish(t, tc.dhcp)
+			r := &redfishNetwork{host: srv.URL, user: "ADMIN", password: "ADMIN", source: tc.source}
+			if err := r.Connect(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+			defer r.Close(context.Background())
+
+			if err := r.SetNetworkSource(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, srv.patchesOf(dedicatedNIC)); diff != "" {
+				t.Fatal(diff)
+			}
+			if p := srv.patchesOf(sharedInterface); len(p) != 0 {
+				t.Fatalf("expected only the interface the BMC is reached at to change, got: %v", p)
+			}
+		})
+	}
+}
+
+func TestRedfishSkipVersions(t *testing.T) {
+	srv := newFakeRedfish(t, true)
+	r := &redfishNetwork{host: srv.URL, user: "ADMIN", password: "ADMIN", skipRedfishVersions: []string{"1.6.0"}}
+	err := r.Connect(context.Background())
+	var re *repository.Error
+	if !errors.As(err, &re) || re.Message != "redfish version 1.6.0 is not compatible" {
+		t.Fatalf("expected the redfish version to be skipped, got: %v", err)
+	}
+}
+
+func TestNetworkSource(t *testing.T) {
+	tests := map[string]struct {
+		skipRedfishVersions []string
+		wantPatches         int
+		wantErr             bool
+	}{
+		"redfish":         {wantPatches: 1},
+		"redfish skipped": {skipRedfishVersions: []string{"1.6.0"}, wantErr: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// without ipmitool, Redfish is the only provider.
+			t.Setenv("PATH", "")
+			srv := newFakeRedfish(t, true)
+			a, err := NewBMCResetter(WithLogger(logr.Discard()), WithSkipRedfishVersions(tc.skipRedfishVersions), WithNetworkSourceRequest(&v1.NetworkSourceRequest{
+				Authn:         directAuthn(srv.URL),
+				NetworkSource: v1.NetworkSource_NETWORK_SOURCE_STATIC,
+			}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = a.NetworkSource(context.Background())
+			var re *repository.Error
+			if tc.wantErr != (err != nil) || (err != nil && !errors.As(err, &re)) {
+				t.Fatalf("expected error %v as a repository error, got: %v", tc.wantErr, err)
+			}
+			if got := len(srv.patchesOf(dedicatedNIC)); got != tc.wantPatches {
+				t.Fatalf("expected %v PATCHes, got: %v", tc.wantPatches, got)
+			}
+		})
+	}
+}
+
+func TestNetworkSourceUnspecified(t *testing.T) {
+	a, err := NewBMCResetter(WithLogger(logr.Discard()), WithNetworkSourceRequest(&v1.NetworkSourceRequest{
+		Authn: &v1.Authn{Authn: &v1.Authn_DirectAuthn{DirectAuthn: &v1.DirectAuthn{Host: &v1.Host{Host: "127.0.0.1"}, Username: "ADMIN", Password: "ADMIN"}}},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = a.NetworkSource(context.Background())
+	var re *repository.Error
+	if !errors.As(err, &re) || re.Code != v1.Code_value["INVALID_ARGUMENT"] {
+		t.Fatalf("expected an invalid argument error, got: %v", err)
+	}
+}
+
+func TestIsLANChannel(t *testing.T) {
+	tests := map[string]struct {
+		info string
+		want bool
+	}{
+		"lan": {
+			info: "Channel 0x1 info:\n  Channel Medium Type   : 802.3 LAN\n  Channel Protocol Type : IPMB-1.0\n",
+			want: true,
+		},
+		"serial": {
+			info: "Channel 0x2 info:\n  Channel Medium Type   : Asynch. Serial/Modem (RS-232)\n  Channel Protocol Type : IPMI-SMBus\n",
+		},
+		"empty": {},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := isLANChannel(tc.info); got != tc.want {
+				t.Fatalf("expected %v, got: %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestIpmitoolArgs(t *testing.T) {
+	tests := map[string]struct {
+		host string
+		want []string
+	}{
+		"address": {host: "192.0.2.10", want: []string{"-I", "lanplus", "-U", "ADMIN", "-E", "-N", "5", "-H", "192.0.2.10", "-C", "3"}},
+		"port":    {host: "192.0.2.10:6230", want: []string{"-I", "lanplus", "-U", "ADMIN", "-E", "-N", "5", "-H", "192.0.2.10", "-p", "6230", "-C", "3"}},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ipmitoolArgs(tc.host, "ADMIN", "3")); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
@@ -24,7 +24,7 @@ type Connection interface {
 // Accessory for all BMC actions.
 type Accessory struct {
 	Log            logr.Logger
-	StatusMessages chan string
+	StatusMessages chan repository.StatusMessage
 	// SkipRedfishVersions is a list of Redfish versions to be ignored,
 	//
 	// When running an action on a BMC, PBnJ will pass the value of the skipRedfishVersions to bmclib
@@ -102,14 +102,22 @@ func (a *Accessory) ParseAuth(auth *v1.Authn) (host string, username string, pas
 	return host, username, passwd, nil
 }
 
-// SendStatusMessage will send a message to a string chan.
+// SendStatusMessage will send an info level status message.
 func (a *Accessory) SendStatusMessage(msg string) {
-	select {
-	case a.StatusMessages <- msg:
+	a.SendStatus(repository.LevelInfo, "", msg)
+}
+
+// SendStatus will send a status message of the given level.
+// provider is the bmclib provider the message is about, empty when not known.
+// It blocks until the message is received, the task runner reads the channel for as
+// long as the task's action runs. Without a StatusMessages channel, e.g. for requests
+// that are not run as tasks, the message is only logged.
+func (a *Accessory) SendStatus(level, provider, msg string) {
+	if a.StatusMessages == nil {
+		a.Log.V(1).Info("no status message receiver", "statusMsg", msg)
 		return
-	case <-time.After(2 * time.Second):
-		a.Log.V(1).Info("timed out waiting for status message receiver", "statusMsg", msg)
 	}
+	a.StatusMessages <- repository.NewStatusMessage(level, provider, msg)
 }
 
 // BMCTimeoutFromCtx returns the time remaining in the context deadline.
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"testing"
-	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
@@ -22,12 +21,10 @@ func TestParseAuth(t *testing.T) {
 		"nil auth":        {input: nil, want: &repository.Error{Code: v1.Code_value["UNAUTHENTICATED"], Message: "no auth found", Details: nil}},
 	}
 	l := logr.Discard()
-	sm := make(chan string)
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			a := Accessory{
-				Log:            l,
-				StatusMessages: sm,
+				Log: l,
 			}
 
 			host, username, passwd, errMsg := a.ParseAuth(tc.input)
@@ -66,32 +63,29 @@ func TestSendStatusMessage(t *testing.T) {
 	}
 
 	l := logr.Discard()
-	sm := make(chan string)
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			var msgs []string
-			done := make(chan bool, 1)
 			a := Accessory{
-				Log:            l,
-				StatusMessages: sm,
+				Log: l,
 			}
 
 			if tc.runChanReceiver {
+				a.StatusMessages = make(chan repository.StatusMessage)
+				done := make(chan struct{})
 				go func() {
-					for {
-						msgs = append(msgs, <-a.StatusMessages)
-						select {
-						case <-done:
-							return
-						default:
-							continue
-						}
+					defer close(done)
+					for msg := range a.StatusMessages {
+						msgs = append(msgs, msg.Text)
 					}
 				}()
 				a.SendStatusMessage(tc.want[0])
 				a.SendStatusMessage(tc.want[1])
-				time.Sleep(10 * time.Millisecond)
-				done <- true
+				close(a.StatusMessages)
+				<-done
+			} else {
+				// without a receiver the message is dropped instead of blocking.
+				a.SendStatusMessage("test message")
 			}
 
 			diff := cmp.Diff(msgs, tc.want)
@@ -91,7 +91,7 @@ func (m Action) ClearSystemEventLog(ctx context.Context) (result string, err err
 	if err != nil {
 		log.Error(err, "error clearing SystemEventLog")
 		span.SetStatus(codes.Error, "error clearing System Event Log: "+err.Error())
-		m.SendStatusMessage(fmt.Sprintf("failed to clear System Event Log %v", host))
+		m.SendStatus(repository.LevelError, "", fmt.Sprintf("failed to clear System Event Log %v", host))
 
 		return "", &repository.Error{
 			Code:    v1.Code_value["UNKNOWN"],
@@ -101,7 +101,7 @@ func (m Action) ClearSystemEventLog(ctx context.Context) (result string, err err
 
 	span.SetStatus(codes.Ok, "")
 	log.Info("cleared System Event Log", logMetadata(client.GetMetadata())...)
-	m.SendStatusMessage(fmt.Sprintf("cleared SystemEvent Log on %v", host))
+	m.SendStatus(repository.LevelInfo, meta.SuccessfulProvider, fmt.Sprintf("cleared SystemEvent Log on %v", host))
 
 	return result, nil
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/go-logr/logr"
 	v1 "github.com/tinkerbell/pbnj/api/v1"
 	common "github.com/tinkerbell/pbnj/grpc/oob"
+	"github.com/tinkerbell/pbnj/pkg/repository"
 )
 
 type Action struct {
@@ -22,7 +23,7 @@ func WithLogger(l logr.Logger) Option {
 }
 
 // WithStatusMessage adds a status message chan to an Action struct.
-func WithStatusMessage(s chan string) Option {
+func WithStatusMessage(s chan repository.StatusMessage) Option {
 	return func(a *Action) error {
 		a.StatusMessages = s
 		return nil
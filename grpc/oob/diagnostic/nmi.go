@@ -85,7 +85,7 @@ func (m Action) SendNMI(ctx context.Context) error {
 	if err != nil {
 		log.Error(err, "error sending NMI")
 		span.SetStatus(codes.Error, "error sending NMI: "+err.Error())
-		m.SendStatusMessage(fmt.Sprintf("failed to send NMI %v", host))
+		m.SendStatus(repository.LevelError, "", fmt.Sprintf("failed to send NMI %v", host))
 
 		return &repository.Error{
 			Code:    v1.Code_value["UNKNOWN"],
@@ -95,7 +95,7 @@ func (m Action) SendNMI(ctx context.Context) error {
 
 	span.SetStatus(codes.Ok, "")
 	log.Info("NMI sent", logMetadata(client.GetMetadata())...)
-	m.SendStatusMessage(fmt.Sprintf("Send NMI to host %v", host))
+	m.SendStatus(repository.LevelInfo, meta.SuccessfulProvider, fmt.Sprintf("Send NMI to host %v", host))
 
 	return nil
 }
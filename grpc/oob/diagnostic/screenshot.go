@@ -92,7 +92,7 @@ func (m Action) GetScreenshot(ctx context.Context) (image []byte, filetype strin
 	if err != nil {
 		log.Error(err, "error getting screenshot")
 		span.SetStatus(codes.Error, "error getting screenshot: "+err.Error())
-		m.SendStatusMessage(fmt.Sprintf("failed to screenshot %v", host))
+		m.SendStatus(repository.LevelError, "", fmt.Sprintf("failed to screenshot %v", host))
 
 		return nil, "", &repository.Error{
 			Code:    v1.Code_value["UNKNOWN"],
@@ -101,7 +101,7 @@ func (m Action) GetScreenshot(ctx context.Context) (image []byte, filetype strin
 	}
 	span.SetStatus(codes.Ok, "")
 	log.Info("got screenshot", logMetadata(client.GetMetadata())...)
-	m.SendStatusMessage(fmt.Sprintf("got screenshot from %v", host))
+	m.SendStatus(repository.LevelInfo, meta.SuccessfulProvider, fmt.Sprintf("got screenshot from %v", host))
 
 	return image, filetype, nil
 }
@@ -13,6 +13,7 @@ import (
 	common "github.com/tinkerbell/pbnj/grpc/oob"
 	"github.com/tinkerbell/pbnj/pkg/metrics"
 	"github.com/tinkerbell/pbnj/pkg/repository"
+	"github.com/tinkerbell/pbnj/pkg/task"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
@@ -38,7 +39,7 @@ func WithLogger(l logr.Logger) Option {
 }
 
 // WithStatusMessage adds a status message chan to an Action struct.
-func WithStatusMessage(s chan string) Option {
+func WithStatusMessage(s chan repository.StatusMessage) Option {
 	return func(a *Action) error {
 		a.StatusMessages = s
 		return nil
@@ -94,7 +95,7 @@ func WithSkipRedfishVersions(versions []string) Option {
 }
 
 // BootDeviceSet functionality for machines.
-func (m Action) BootDeviceSet(ctx context.Context, device string, persistent, efiBoot bool) (result string, err error) {
+func (m Action) BootDeviceSet(ctx context.Context, device string, persistent, efiBoot bool) (result task.Result, err error) {
 	labels := prometheus.Labels{
 		"service": "machine",
 		"action":  "boot_device",
@@ -127,13 +128,13 @@ func (m Action) BootDeviceSet(ctx context.Context, device string, persistent, ef
 		dev = "pxe"
 	case v1.BootDevice_BOOT_DEVICE_UNSPECIFIED.String():
 		span.SetStatus(codes.Error, "UNSPECIFIED boot device")
-		return "", &repository.Error{
+		return task.Result{}, &repository.Error{
 			Code:    v1.Code_value["INVALID_ARGUMENT"],
 			Message: "UNSPECIFIED boot device",
 		}
 	default:
 		span.SetStatus(codes.Error, "unknown boot device")
-		return "", &repository.Error{
+		return task.Result{}, &repository.Error{
 			Code:    v1.Code_value["INVALID_ARGUMENT"],
 			Message: "unknown boot device",
 		}
@@ -168,7 +169,7 @@ func (m Action) BootDeviceSet(ctx context.Context, device string, persistent, ef
 		attribute.StringSlice("bmc.open.successfulOpenConns", meta.SuccessfulOpenConns))
 	if err != nil {
 		span.SetStatus(codes.Error, err.Error())
-		return "", &repository.Error{
+		return task.Result{}, &repository.Error{
 			Code:    v1.Code_value["PERMISSION_DENIED"],
 			Message: err.Error(),
 		}
@@ -194,9 +195,9 @@ func (m Action) BootDeviceSet(ctx context.Context, device string, persistent, ef
 	if err != nil {
 		span.SetStatus(codes.Error, "failed to set boot device: "+err.Error())
 		log.Error(err, fmt.Sprintf("error with %v", base))
-		m.SendStatusMessage(fmt.Sprintf("failed to set %v as boot device", dev))
+		m.SendStatus(repository.LevelError, "", fmt.Sprintf("failed to set %v as boot device", dev))
 
-		return "", &repository.Error{
+		return task.Result{}, &repository.Error{
 			Code:    v1.Code_value["UNKNOWN"],
 			Message: err.Error(),
 		}
@@ -204,13 +205,18 @@ func (m Action) BootDeviceSet(ctx context.Context, device string, persistent, ef
 
 	span.SetStatus(codes.Ok, "")
 	log.Info(base + " complete")
-	m.SendStatusMessage(base + " complete")
+	m.SendStatus(repository.LevelInfo, meta.SuccessfulProvider, base+" complete")
 
+	result.Typed = &v1.TaskResult{Result: &v1.TaskResult_BootDevice{BootDevice: &v1.BootDeviceResult{
+		BootDevice: m.BootDeviceRequest.GetBootDevice(),
+		Persistent: persistent,
+		EfiBoot:    efiBoot,
+	}}}
 	return result, nil
 }
 
 // PowerSet functionality for machines.
-func (m Action) PowerSet(ctx context.Context, action string) (result string, err error) {
+func (m Action) PowerSet(ctx context.Context, action string) (result task.Result, err error) {
 	labels := prometheus.Labels{
 		"service": "machine",
 		"action":  "power",
@@ -243,14 +249,14 @@ func (m Action) PowerSet(ctx context.Context, action string) (result string, err
 		pwrAction = "cycle"
 	case v1.PowerAction_POWER_ACTION_UNSPECIFIED.String():
 		span.SetStatus(codes.Error, "UNSPECIFIED power action")
-		return "", &repository.Error{
+		return task.Result{}, &repository.Error{
 			Code:    v1.Code_value["INVALID_ARGUMENT"],
 			Message: "UNSPECIFIED power action",
 		}
 	default:
 		msg := fmt.Sprintf("unknown power action: %q", action)
 		span.SetStatus(codes.Error, msg)
-		return "", &repository.Error{
+		return task.Result{}, &repository.Error{
 			Code:    v1.Code_value["INVALID_ARGUMENT"],
 			Message: msg,
 		}
@@ -277,9 +283,9 @@ func (m Action) PowerSet(ctx context.Context, action string) (result string, err
 	err = client.Open(ctx)
 	if err != nil {
 		span.SetStatus(codes.Error, "connecting to BMC failed: "+err.Error())
-		m.SendStatusMessage("connecting to BMC failed")
+		m.SendStatus(repository.LevelError, "", "connecting to BMC failed")
 
-		return "", &repository.Error{
+		return task.Result{}, &repository.Error{
 			Code:    v1.Code_value["UNKNOWN"],
 			Message: err.Error(),
 		}
@@ -307,8 +313,8 @@ func (m Action) PowerSet(ctx context.Context, action string) (result string, err
 	if err != nil {
 		span.SetStatus(codes.Error, "failed to get power state: "+err.Error())
 		log.Error(err, "failed to get power state")
-		m.SendStatusMessage("error getting power state: " + err.Error())
-		return "", &repository.Error{
+		m.SendStatus(repository.LevelError, "", "error getting power state: "+err.Error())
+		return task.Result{}, &repository.Error{
 			Code:    v1.Code_value["UNKNOWN"],
 			Message: err.Error(),
 		}
@@ -318,7 +324,7 @@ func (m Action) PowerSet(ctx context.Context, action string) (result string, err
 
 	ok := true
 	if pwrAction == "status" {
-		result = currentPowerState
+		result.Text = currentPowerState
 	} else {
 		if action == v1.PowerAction_POWER_ACTION_CYCLE.String() {
 			// check status
@@ -329,7 +335,7 @@ func (m Action) PowerSet(ctx context.Context, action string) (result string, err
 			}
 		}
 		ok, err = client.SetPowerState(ctx, pwrAction)
-		result = fmt.Sprintf("%v complete", base)
+		result.Text = fmt.Sprintf("%v complete", base)
 		meta = client.GetMetadata()
 		span.SetAttributes(attribute.String("bmc.setPowerState.successfulProvider", meta.SuccessfulProvider),
 			attribute.StringSlice("bmc.setPowerState.providersAttempted", meta.ProvidersAttempted))
@@ -338,7 +344,7 @@ func (m Action) PowerSet(ctx context.Context, action string) (result string, err
 	if err != nil {
 		span.SetStatus(codes.Error, "failed to set power state: "+base+": "+err.Error())
 		log.Error(err, "failed to set power state "+base)
-		m.SendStatusMessage("error with " + base + ": " + err.Error())
+		m.SendStatus(repository.LevelError, "", "error with "+base+": "+err.Error())
 	}
 	if !ok && err == nil {
 		span.SetStatus(codes.Error, "failed to set power state")
@@ -349,7 +355,7 @@ func (m Action) PowerSet(ctx context.Context, action string) (result string, err
 		span.SetStatus(codes.Error, err.Error())
 		log.Error(err, fmt.Sprintf("error completing power %v action", action))
 
-		return "", &repository.Error{
+		return task.Result{}, &repository.Error{
 			Code:    v1.Code_value["UNKNOWN"],
 			Message: err.Error(),
 		}
@@ -357,11 +363,30 @@ func (m Action) PowerSet(ctx context.Context, action string) (result string, err
 
 	span.SetStatus(codes.Ok, "")
 	log.Info(base + " complete")
-	m.SendStatusMessage(base + " complete")
+	m.SendStatus(repository.LevelInfo, meta.SuccessfulProvider, base+" complete")
 
+	typed := &v1.PowerResult{PowerAction: m.PowerRequest.GetPowerAction()}
+	if pwrAction == "status" {
+		typed.RawState = currentPowerState
+		typed.State = powerState(currentPowerState)
+	}
+	result.Typed = &v1.TaskResult{Result: &v1.TaskResult_Power{Power: typed}}
 	return result, nil
 }
 
+// powerState converts a power state reported by bmclib, e.g. "off - soft", to a v1.PowerState.
+func powerState(state string) v1.PowerState {
+	state = strings.ToLower(state)
+	switch {
+	case strings.Contains(state, "off"):
+		return v1.PowerState_POWER_STATE_OFF
+	case strings.Contains(state, "on"):
+		return v1.PowerState_POWER_STATE_ON
+	default:
+		return v1.PowerState_POWER_STATE_UNSPECIFIED
+	}
+}
+
 func logMetadata(md bmc.Metadata) []interface{} {
 	kvs := []interface{}{
 		"ProvidersAttempted", md.ProvidersAttempted,
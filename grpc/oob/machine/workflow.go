@@ -0,0 +1,214 @@
+package machine
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "github.com/tinkerbell/pbnj/api/v1"
+	"github.com/tinkerbell/pbnj/pkg/repository"
+	"github.com/tinkerbell/pbnj/pkg/task"
+)
+
+// defaultPollInterval is the time between power state checks of a verify power step.
+const defaultPollInterval = 5 * time.Second
+
+// Workflow runs the steps of a WorkflowRequest in order, stopping at the first
+// step that fails. It remembers the steps that completed, so running it again,
+// e.g. when the task is retried, resumes at the step that failed.
+type Workflow struct {
+	Action
+	Request *v1.WorkflowRequest
+	// StepTimeout is how long each step may run, not counting the time a wait or
+	// verify power step asks for. Zero leaves the steps to the deadline of the context.
+	StepTimeout time.Duration
+	// next is the index of the first step that has not completed.
+	next    int
+	results []*v1.TaskResult
+	// power and bootDevice do the power and boot device steps.
+	power      func(ctx context.Context, in *v1.PowerRequest) (task.Result, error)
+	bootDevice func(ctx context.Context, in *v1.DeviceRequest) (task.Result, error)
+}
+
+// NewWorkflow returns a Workflow for the request.
+func NewWorkflow(in *v1.WorkflowRequest, opts ...Option) (*Workflow, error) {
+	w := &Workflow{Request: in}
+	for _, opt := range opts {
+		if err := opt(&w.Action); err != nil {
+			return nil, err
+		}
+	}
+	w.power = func(ctx context.Context, in *v1.PowerRequest) (task.Result, error) {
+		a := w.Action
+		a.PowerRequest = in
+		return a.PowerSet(ctx, in.GetPowerAction().String())
+	}
+	w.bootDevice = func(ctx context.Context, in *v1.DeviceRequest) (task.Result, error) {
+		a := w.Action
+		a.BootDeviceRequest = in
+		return a.BootDeviceSet(ctx, in.GetBootDevice().String(), in.GetPersistent(), in.GetEfiBoot())
+	}
+	return w, nil
+}
+
+// WorkflowDescription describes the steps of a workflow, e.g.
+// "workflow: boot device BOOT_DEVICE_PXE, power POWER_ACTION_CYCLE, verify power POWER_STATE_ON".
+func WorkflowDescription(in *v1.WorkflowRequest) string {
+	steps := make([]string, 0, len(in.GetSteps()))
+	for _, step := range in.GetSteps() {
+		steps = append(steps, stepDescription(step))
+	}
+	return "workflow: " + strings.Join(steps, ", ")
+}
+
+func stepDescription(step *v1.WorkflowStep) string {
+	switch s := step.GetStep().(type) {
+	case *v1.WorkflowStep_BootDevice:
+		return "boot device " + s.BootDevice.GetBootDevice().String()
+	case *v1.WorkflowStep_Power:
+		return "power " + s.Power.GetPowerAction().String()
+	case *v1.WorkflowStep_Wait:
+		return "wait " + (time.Duration(s.Wait.GetDurationMs()) * time.Millisecond).String()
+	case *v1.WorkflowStep_VerifyPower:
+		return "verify power " + s.VerifyPower.GetState().String()
+	default:
+		return "unknown step"
+	}
+}
+
+// Run runs the steps that have not completed yet, sending status messages to s.
+// The result holds the result of every step.
+func (w *Workflow) Run(ctx context.Context, s chan repository.StatusMessage) (task.Result, error) {
+	w.StatusMessages = s
+	steps := w.Request.GetSteps()
+	for ; w.next < len(steps); w.next++ {
+		step := steps[w.next]
+		name := fmt.Sprintf("step %d of %d (%v)", w.next+1, len(steps), stepDescription(step))
+		w.SendStatusMessage("starting " + name)
+		result, err := w.runStep(ctx, step)
+		if err != nil {
+			w.SendStatus(repository.LevelError, "", fmt.Sprintf("%v failed: %v", name, err))
+			return task.Result{}, stepError(name, err)
+		}
+		w.SendStatusMessage(name + " complete")
+		w.results = append(w.results, result.Typed)
+	}
+
+	results := make([]*v1.TaskResult, len(w.results))
+	for i, r := range w.results {
+		if r == nil {
+			r = &v1.TaskResult{}
+		}
+		results[i] = r
+	}
+	return task.Result{
+		Text:  fmt.Sprintf("workflow of %d steps complete", len(steps)),
+		Typed: &v1.TaskResult{Result: &v1.TaskResult_Workflow{Workflow: &v1.WorkflowResult{Steps: results}}},
+	}, nil
+}
+
+// runStep runs a step within its timeout.
+func (w *Workflow) runStep(ctx context.Context, step *v1.WorkflowStep) (task.Result, error) {
+	if w.StepTimeout <= 0 {
+		return w.step(ctx, step)
+	}
+	stepCtx, cancel := context.WithTimeout(ctx, w.stepTimeout(step))
+	defer cancel()
+	return w.step(stepCtx, step)
+}
+
+// stepTimeout returns StepTimeout plus the time the step asks for.
+func (w *Workflow) stepTimeout(step *v1.WorkflowStep) time.Duration {
+	switch s := step.GetStep().(type) {
+	case *v1.WorkflowStep_Wait:
+		return w.StepTimeout + time.Duration(s.Wait.GetDurationMs())*time.Millisecond
+	case *v1.WorkflowStep_VerifyPower:
+		return w.StepTimeout + time.Duration(s.VerifyPower.GetTimeoutMs())*time.Millisecond
+	default:
+		return w.StepTimeout
+	}
+}
+
+func (w *Workflow) step(ctx context.Context, step *v1.WorkflowStep) (task.Result, error) {
+	switch s := step.GetStep().(type) {
+	case *v1.WorkflowStep_BootDevice:
+		return w.bootDevice(ctx, &v1.DeviceRequest{
+			Authn:      w.Request.GetAuthn(),
+			Vendor:     w.Request.GetVendor(),
+			BootDevice: s.BootDevice.GetBootDevice(),
+			Persistent: s.BootDevice.GetPersistent(),
+			EfiBoot:    s.BootDevice.GetEfiBoot(),
+		})
+	case *v1.WorkflowStep_Power:
+		return w.power(ctx, &v1.PowerRequest{
+			Authn:       w.Request.GetAuthn(),
+			Vendor:      w.Request.GetVendor(),
+			PowerAction: s.Power.GetPowerAction(),
+			SoftTimeout: s.Power.GetSoftTimeout(),
+			OffDuration: s.Power.GetOffDuration(),
+		})
+	case *v1.WorkflowStep_Wait:
+		return task.Result{}, sleep(ctx, time.Duration(s.Wait.GetDurationMs())*time.Millisecond)
+	case *v1.WorkflowStep_VerifyPower:
+		return w.verifyPower(ctx, s.VerifyPower)
+	default:
+		return task.Result{}, &repository.Error{
+			Code:    v1.Code_value["INVALID_ARGUMENT"],
+			Message: "unknown workflow step",
+		}
+	}
+}
+
+// verifyPower checks the power state until it is the expected one or the step's timeout passes.
+func (w *Workflow) verifyPower(ctx context.Context, v *v1.VerifyPowerStep) (task.Result, error) {
+	deadline := time.Now().Add(time.Duration(v.GetTimeoutMs()) * time.Millisecond)
+	interval := time.Duration(v.GetPollIntervalMs()) * time.Millisecond
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	for {
+		result, err := w.power(ctx, &v1.PowerRequest{
+			Authn:       w.Request.GetAuthn(),
+			Vendor:      w.Request.GetVendor(),
+			PowerAction: v1.PowerAction_POWER_ACTION_STATUS,
+		})
+		if err != nil {
+			return task.Result{}, err
+		}
+		state := result.Typed.GetPower().GetState()
+		if state == v.GetState() {
+			return result, nil
+		}
+		if !time.Now().Add(interval).Before(deadline) {
+			return task.Result{}, &repository.Error{
+				Code:    v1.Code_value["FAILED_PRECONDITION"],
+				Message: fmt.Sprintf("power state is %v, expected %v", state, v.GetState()),
+			}
+		}
+		w.SendStatusMessage(fmt.Sprintf("power state is %v, waiting for %v", state, v.GetState()))
+		if err := sleep(ctx, interval); err != nil {
+			return task.Result{}, err
+		}
+	}
+}
+
+// stepError adds the step to the message of err, keeping its code.
+func stepError(name string, err error) error {
+	if re, ok := err.(*repository.Error); ok {
+		return &repository.Error{Code: re.Code, Message: name + ": " + re.Message, Details: re.Details}
+	}
+	return fmt.Errorf("%v: %w", name, err)
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
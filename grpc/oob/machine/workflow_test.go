@@ -0,0 +1,131 @@
+package machine
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	v1 "github.com/tinkerbell/pbnj/api/v1"
+	"github.com/tinkerbell/pbnj/pkg/repository"
+	"github.com/tinkerbell/pbnj/pkg/task"
+)
+
+func TestWorkflow(t *testing.T) {
+	in := &v1.WorkflowRequest{Steps: []*v1.WorkflowStep{
+		{Step: &v1.WorkflowStep_BootDevice{BootDevice: &v1.BootDeviceStep{BootDevice: v1.BootDevice_BOOT_DEVICE_PXE}}},
+		{Step: &v1.WorkflowStep_Power{Power: &v1.PowerStep{PowerAction: v1.PowerAction_POWER_ACTION_CYCLE}}},
+		{Step: &v1.WorkflowStep_Wait{Wait: &v1.WaitStep{DurationMs: 1}}},
+		{Step: &v1.WorkflowStep_VerifyPower{VerifyPower: &v1.VerifyPowerStep{State: v1.PowerState_POWER_STATE_ON, TimeoutMs: 1000, PollIntervalMs: 1}}},
+	}}
+	if got, want := WorkflowDescription(in), "workflow: boot device BOOT_DEVICE_PXE, power POWER_ACTION_CYCLE, wait 1ms, verify power POWER_STATE_ON"; got != want {
+		t.Fatalf("expected description %q, got: %q", want, got)
+	}
+
+	w, err := NewWorkflow(in, WithLogger(logr.Discard()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var calls []string
+	failCycle := true
+	statusChecks := 0
+	w.bootDevice = func(_ context.Context, in *v1.DeviceRequest) (task.Result, error) {
+		calls = append(calls, in.GetBootDevice().String())
+		return task.Result{Typed: &v1.TaskResult{Result: &v1.TaskResult_BootDevice{BootDevice: &v1.BootDeviceResult{BootDevice: in.GetBootDevice()}}}}, nil
+	}
+	w.power = func(_ context.Context, in *v1.PowerRequest) (task.Result, error) {
+		calls = append(calls, in.GetPowerAction().String())
+		typed := &v1.PowerResult{PowerAction: in.GetPowerAction()}
+		switch in.GetPowerAction() {
+		case v1.PowerAction_POWER_ACTION_CYCLE:
+			if failCycle {
+				failCycle = false
+				return task.Result{}, &repository.Error{Code: v1.Code_value["UNAVAILABLE"], Message: "bmc unreachable"}
+			}
+		case v1.PowerAction_POWER_ACTION_STATUS:
+			// the machine reports off once before it is on.
+			statusChecks++
+			typed.State = v1.PowerState_POWER_STATE_OFF
+			if statusChecks > 1 {
+				typed.State = v1.PowerState_POWER_STATE_ON
+			}
+		}
+		return task.Result{Typed: &v1.TaskResult{Result: &v1.TaskResult_Power{Power: typed}}}, nil
+	}
+	messages := make(chan repository.StatusMessage, 100)
+
+	_, err = w.Run(context.Background(), messages)
+	var re *repository.Error
+	if !errors.As(err, &re) || re.Code != v1.Code_value["UNAVAILABLE"] || re.Message != "step 2 of 4 (power POWER_ACTION_CYCLE): bmc unreachable" {
+		t.Fatalf("expected the power step to fail, got: %v", err)
+	}
+
+	// running it again resumes at the step that failed.
+	result, err := w.Run(context.Background(), messages)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantCalls := []string{"BOOT_DEVICE_PXE", "POWER_ACTION_CYCLE", "POWER_ACTION_CYCLE", "POWER_ACTION_STATUS", "POWER_ACTION_STATUS"}
+	if diff := cmp.Diff(wantCalls, calls); diff != "" {
+		t.Fatal(diff)
+	}
+	steps := result.Typed.GetWorkflow().GetSteps()
+	if len(steps) != 4 || steps[0].GetBootDevice() == nil || steps[1].GetPower() == nil || steps[2].GetResult() != nil || steps[3].GetPower().GetState() != v1.PowerState_POWER_STATE_ON {
+		t.Fatalf("unexpected step results: %v", steps)
+	}
+}
+
+func TestWorkflowVerifyPowerFails(t *testing.T) {
+	in := &v1.WorkflowRequest{Steps: []*v1.WorkflowStep{
+		{Step: &v1.WorkflowStep_VerifyPower{VerifyPower: &v1.VerifyPowerStep{State: v1.PowerState_POWER_STATE_ON}}},
+		{Step: &v1.WorkflowStep_Power{Power: &v1.PowerStep{PowerAction: v1.PowerAction_POWER_ACTION_OFF}}},
+	}}
+	w, err := NewWorkflow(in, WithLogger(logr.Discard()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls := 0
+	w.power = func(_ context.Context, in *v1.PowerRequest) (task.Result, error) {
+		calls++
+		return task.Result{Typed: &v1.TaskResult{Result: &v1.TaskResult_Power{Power: &v1.PowerResult{State: v1.PowerState_POWER_STATE_OFF}}}}, nil
+	}
+
+	_, err = w.Run(context.Background(), make(chan repository.StatusMessage, 100))
+	var re *repository.Error
+	if !errors.As(err, &re) || re.Code != v1.Code_value["FAILED_PRECONDITION"] {
+		t.Fatalf("expected the verify step to fail, got: %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected a single power state check and no further steps, got %v power calls", calls)
+	}
+}
+
+func TestWorkflowStepTimeout(t *testing.T) {
+	in := &v1.WorkflowRequest{Steps: []*v1.WorkflowStep{
+		{Step: &v1.WorkflowStep_Power{Power: &v1.PowerStep{PowerAction: v1.PowerAction_POWER_ACTION_OFF}}},
+		{Step: &v1.WorkflowStep_Wait{Wait: &v1.WaitStep{DurationMs: 100}}},
+		{Step: &v1.WorkflowStep_Power{Power: &v1.PowerStep{PowerAction: v1.PowerAction_POWER_ACTION_ON}}},
+		{Step: &v1.WorkflowStep_Power{Power: &v1.PowerStep{PowerAction: v1.PowerAction_POWER_ACTION_CYCLE}}},
+	}}
+	w, err := NewWorkflow(in, WithLogger(logr.Discard()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.StepTimeout = 50 * time.Millisecond
+	// each power step takes most of the step timeout, together more than it; the last one hangs.
+	w.power = func(ctx context.Context, in *v1.PowerRequest) (task.Result, error) {
+		d := 30 * time.Millisecond
+		if in.GetPowerAction() == v1.PowerAction_POWER_ACTION_CYCLE {
+			d = time.Second
+		}
+		return task.Result{}, sleep(ctx, d)
+	}
+
+	_, err = w.Run(context.Background(), make(chan repository.StatusMessage, 100))
+	if !errors.Is(err, context.DeadlineExceeded) || !strings.HasPrefix(err.Error(), "step 4 of 4") {
+		t.Fatalf("expected only the last step to time out, got: %v", err)
+	}
+}
@@ -0,0 +1,184 @@
+package persistence
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/tinkerbell/pbnj/pkg/repository"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// BoltFileName is the name of the database file created in the data directory.
+	BoltFileName = "pbnj.db"
+
+	// tasksBucket holds all task records, keyed by task ID.
+	tasksBucket = "tasks"
+	// keysBucket holds the key claims made with ClaimKey.
+	keysBucket = "keys"
+)
+
+// Bolt store, methods implement repository.Actions interface.
+// Records are written to a single file on disk so they survive restarts.
+type Bolt struct {
+	DB *bolt.DB
+}
+
+// NewBolt opens, creating if needed, the task database in dataDir.
+func NewBolt(dataDir string) (*Bolt, error) {
+	if err := os.MkdirAll(dataDir, 0o750); err != nil {
+		return nil, fmt.Errorf("unable to create data directory: %w", err)
+	}
+	db, err := bolt.Open(filepath.Join(dataDir, BoltFileName), 0o600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("unable to open task database: %w", err)
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, name := range []string{tasksBucket, keysBucket} {
+			if _, err := tx.CreateBucketIfNotExists([]byte(name)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &Bolt{DB: db}, nil
+}
+
+// Close the underlying database.
+func (b *Bolt) Close() error {
+	return b.DB.Close()
+}
+
+// Create a record.
+func (b *Bolt) Create(id string, val repository.Record) error {
+	data, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	return b.DB.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(tasksBucket)).Put([]byte(id), data)
+	})
+}
+
+// Get a record.
+func (b *Bolt) Get(id string) (repository.Record, error) {
+	rec := new(repository.Record)
+	err := b.DB.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket([]byte(tasksBucket)).Get([]byte(id))
+		if data == nil {
+			return fmt.Errorf("%w: %v", repository.ErrNotFound, id)
+		}
+		return json.Unmarshal(data, rec)
+	})
+	return *rec, err
+}
+
+// Update a record, if the stored record has the same version.
+// The check and the write happen in one transaction.
+func (b *Bolt) Update(id string, val repository.Record) error {
+	expected := val.Version
+	val.Version++
+	data, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	return b.DB.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket([]byte(tasksBucket))
+		stored := bkt.Get([]byte(id))
+		if stored == nil {
+			return fmt.Errorf("%w: %v", repository.ErrNotFound, id)
+		}
+		var rec repository.Record
+		if err := json.Unmarshal(stored, &rec); err != nil {
+			return err
+		}
+		if rec.Version != expected {
+			return fmt.Errorf("%w: record %v is at version %v, not %v", repository.ErrConflict, id, rec.Version, expected)
+		}
+		return bkt.Put([]byte(id), data)
+	})
+}
+
+// Delete a record, if it exists.
+func (b *Bolt) Delete(id string) error {
+	return b.DB.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket([]byte(tasksBucket))
+		if bkt.Get([]byte(id)) == nil {
+			return fmt.Errorf("%w: %v", repository.ErrNotFound, id)
+		}
+		return bkt.Delete([]byte(id))
+	})
+}
+
+// List the records that match the filter.
+func (b *Bolt) List(filter repository.Filter) ([]repository.Record, error) {
+	var records []repository.Record
+	err := b.DB.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(tasksBucket)).ForEach(func(_, data []byte) error {
+			var rec repository.Record
+			if err := json.Unmarshal(data, &rec); err != nil {
+				return err
+			}
+			if filter.Match(rec) {
+				records = append(records, rec)
+			}
+			return nil
+		})
+	})
+	return records, err
+}
+
+// ClaimKey claims key for id, unless an unexpired claim holds it, and returns
+// the id holding the key. The claim's deadline is stored with it. The check and
+// the write happen in one transaction.
+func (b *Bolt) ClaimKey(key, id string, ttl time.Duration) (string, error) {
+	holder := id
+	err := b.DB.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket([]byte(keysBucket))
+		if stored := bkt.Get([]byte(key)); stored != nil {
+			var claim repository.KeyClaim
+			if err := json.Unmarshal(stored, &claim); err != nil {
+				return err
+			}
+			if claim.Held(time.Now()) {
+				holder = claim.ID
+				return nil
+			}
+		}
+		data, err := json.Marshal(repository.NewKeyClaim(id, ttl))
+		if err != nil {
+			return err
+		}
+		return bkt.Put([]byte(key), data)
+	})
+	if err != nil {
+		return "", err
+	}
+	return holder, nil
+}
+
+// ReleaseKey drops the claim on key, if id holds it.
+func (b *Bolt) ReleaseKey(key, id string) error {
+	return b.DB.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket([]byte(keysBucket))
+		stored := bkt.Get([]byte(key))
+		if stored == nil {
+			return nil
+		}
+		var claim repository.KeyClaim
+		if err := json.Unmarshal(stored, &claim); err != nil {
+			return err
+		}
+		if claim.ID != id {
+			return nil
+		}
+		return bkt.Delete([]byte(key))
+	})
+}
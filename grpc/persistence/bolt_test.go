@@ -0,0 +1,164 @@
+package persistence
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+	"github.com/tinkerbell/pbnj/pkg/repository"
+)
+
+func TestBoltAllMethods(t *testing.T) {
+	id := "1234567"
+	repo, err := NewBolt(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer repo.Close()
+	record := repository.Record{
+		ID:          id,
+		Description: "test record",
+		Error:       &repository.Error{Code: 2, Message: "bad thing", Details: []string{"detail"}},
+		State:       "running",
+		Messages:    []string{"connecting to BMC"},
+	}
+	err = repo.Create(id, record)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := repo.Get(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := deep.Equal(record, result); diff != nil {
+		t.Fatal(diff)
+	}
+
+	// update record
+	result.Complete = true
+	result.Result = "did a good thing"
+	result.State = "complete"
+	err = repo.Update(id, result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result.Version++
+
+	updatedResult, err := repo.Get(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := deep.Equal(result, updatedResult); diff != nil {
+		t.Fatal(diff)
+	}
+
+	records, err := repo.List(repository.Filter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := deep.Equal([]repository.Record{updatedResult}, records); diff != nil {
+		t.Fatal(diff)
+	}
+
+	// delete record
+	err = repo.Delete(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.Get(id); err == nil {
+		t.Fatal("expected record to be deleted")
+	}
+}
+
+func TestBoltSurvivesReopen(t *testing.T) {
+	id := "1234567"
+	dir := t.TempDir()
+	record := repository.Record{
+		ID:          id,
+		Description: "test record",
+		State:       "complete",
+		Result:      "on",
+		Complete:    true,
+		Messages:    []string{"connected to BMC", "power POWER_ACTION_STATUS complete"},
+	}
+
+	repo, err := NewBolt(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Create(id, record); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	repo, err = NewBolt(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer repo.Close()
+	result, err := repo.Get(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := deep.Equal(record, result); diff != nil {
+		t.Fatal(diff)
+	}
+}
+
+func TestBoltUpdateConflict(t *testing.T) {
+	repo, err := NewBolt(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer repo.Close()
+	testUpdateConflict(t, repo)
+}
+
+func TestBoltDelete(t *testing.T) {
+	repo, err := NewBolt(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer repo.Close()
+	testDelete(t, repo)
+}
+
+func TestBoltClaimKey(t *testing.T) {
+	repo, err := NewBolt(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer repo.Close()
+	testClaimKey(t, repo, time.Sleep)
+}
+
+func TestBoltRecordNotFound(t *testing.T) {
+	id := "123"
+	expectedError := fmt.Sprintf("record id not found: %v", id)
+	repo, err := NewBolt(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer repo.Close()
+
+	_, err = repo.Get(id)
+	if err == nil {
+		t.Fatalf("expecting NON nil error")
+	}
+	if !strings.Contains(err.Error(), expectedError) {
+		t.Fatalf("expected: %v, got: %v", expectedError, err.Error())
+	}
+
+	err = repo.Update(id, repository.Record{})
+	if err == nil {
+		t.Fatalf("expecting NON nil error")
+	}
+	if !strings.Contains(err.Error(), expectedError) {
+		t.Fatalf("expected: %v, got: %v", expectedError, err.Error())
+	}
+}
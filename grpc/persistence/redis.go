@@ -0,0 +1,309 @@
+package persistence
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/tinkerbell/pbnj/pkg/repository"
+)
+
+// DefaultRedisKeyPrefix is prepended to task IDs to form Redis keys.
+const DefaultRedisKeyPrefix = "pbnj:task:"
+
+// maxClaimAttempts is how many times ClaimKey tries to claim a key whose claim expires meanwhile.
+const maxClaimAttempts = 3
+
+// Redis store, methods implement repository.Actions interface.
+// Records are kept in a Redis protocol server so that every PBnJ
+// replica pointed at the same server sees the same tasks.
+type Redis struct {
+	Ctx    context.Context
+	Client redis.UniversalClient
+	// KeyPrefix namespaces task records in the Redis keyspace.
+	KeyPrefix string
+}
+
+// RedisOptions for connecting to a Redis server.
+type RedisOptions struct {
+	Addr     string
+	Username string
+	Password string
+	DB       int
+	// KeyPrefix defaults to DefaultRedisKeyPrefix.
+	KeyPrefix string
+
+	// TLS enables TLS for the connection. The remaining TLS fields are only used when TLS is true.
+	TLS bool
+	// TLSCAFile is a PEM bundle used to verify the server. The system pool is used when empty.
+	TLSCAFile string
+	// TLSCertFile and TLSKeyFile are a PEM client certificate and key for mutual TLS.
+	TLSCertFile string
+	TLSKeyFile  string
+	// TLSServerName overrides the server name used to verify the server certificate.
+	TLSServerName         string
+	TLSInsecureSkipVerify bool
+}
+
+// NewRedis connects to a Redis server and verifies it is reachable.
+func NewRedis(ctx context.Context, opts RedisOptions) (*Redis, error) {
+	if opts.Addr == "" {
+		return nil, errors.New("redis address is required")
+	}
+	o := &redis.Options{
+		Addr:     opts.Addr,
+		Username: opts.Username,
+		Password: opts.Password,
+		DB:       opts.DB,
+	}
+	if opts.TLS {
+		tc, err := opts.tlsConfig()
+		if err != nil {
+			return nil, err
+		}
+		o.TLSConfig = tc
+	}
+	prefix := opts.KeyPrefix
+	if prefix == "" {
+		prefix = DefaultRedisKeyPrefix
+	}
+	r := &Redis{
+		Ctx:       ctx,
+		Client:    redis.NewClient(o),
+		KeyPrefix: prefix,
+	}
+	if err := r.Client.Ping(ctx).Err(); err != nil {
+		r.Client.Close()
+		return nil, fmt.Errorf("unable to connect to redis at %v: %w", opts.Addr, err)
+	}
+	return r, nil
+}
+
+func (o RedisOptions) tlsConfig() (*tls.Config, error) {
+	tc := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         o.TLSServerName,
+		InsecureSkipVerify: o.TLSInsecureSkipVerify, //nolint:gosec // opt-in for self-signed test environments
+	}
+	if o.TLSCAFile != "" {
+		pem, err := os.ReadFile(o.TLSCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read redis CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in redis CA file: %v", o.TLSCAFile)
+		}
+		tc.RootCAs = pool
+	}
+	if o.TLSCertFile != "" || o.TLSKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(o.TLSCertFile, o.TLSKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load redis client certificate: %w", err)
+		}
+		tc.Certificates = []tls.Certificate{cert}
+	}
+	return tc, nil
+}
+
+// Close the underlying client.
+func (r *Redis) Close() error {
+	return r.Client.Close()
+}
+
+func (r *Redis) key(id string) string {
+	return r.KeyPrefix + id
+}
+
+// Create a record.
+func (r *Redis) Create(id string, val repository.Record) error {
+	data, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	return r.Client.Set(r.Ctx, r.key(id), data, 0).Err()
+}
+
+// Get a record.
+func (r *Redis) Get(id string) (repository.Record, error) {
+	rec := new(repository.Record)
+	data, err := r.Client.Get(r.Ctx, r.key(id)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return *rec, fmt.Errorf("%w: %v", repository.ErrNotFound, id)
+	}
+	if err != nil {
+		return *rec, err
+	}
+	err = json.Unmarshal(data, rec)
+	return *rec, err
+}
+
+// Update a record, if the stored record has the same version.
+// The key is WATCHed while its version is checked, so the write fails
+// if another client changes the record in the meantime.
+func (r *Redis) Update(id string, val repository.Record) error {
+	expected := val.Version
+	val.Version++
+	data, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	key := r.key(id)
+	err = r.Client.Watch(r.Ctx, func(tx *redis.Tx) error {
+		stored, err := tx.Get(r.Ctx, key).Bytes()
+		if errors.Is(err, redis.Nil) {
+			return fmt.Errorf("%w: %v", repository.ErrNotFound, id)
+		}
+		if err != nil {
+			return err
+		}
+		var rec repository.Record
+		if err := json.Unmarshal(stored, &rec); err != nil {
+			return err
+		}
+		if rec.Version != expected {
+			return fmt.Errorf("%w: record %v is at version %v, not %v", repository.ErrConflict, id, rec.Version, expected)
+		}
+		_, err = tx.TxPipelined(r.Ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(r.Ctx, key, data, redis.KeepTTL)
+			return nil
+		})
+		return err
+	}, key)
+	if errors.Is(err, redis.TxFailedErr) {
+		return fmt.Errorf("%w: record %v changed while it was updated", repository.ErrConflict, id)
+	}
+	return err
+}
+
+// Delete a record, if it exists.
+func (r *Redis) Delete(id string) error {
+	n, err := r.Client.Del(r.Ctx, r.key(id)).Result()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: %v", repository.ErrNotFound, id)
+	}
+	return nil
+}
+
+// List the records under the key prefix that match the filter.
+func (r *Redis) List(filter repository.Filter) ([]repository.Record, error) {
+	var records []repository.Record
+	var cursor uint64
+	for {
+		keys, next, err := r.Client.Scan(r.Ctx, cursor, r.KeyPrefix+"*", 100).Result()
+		if err != nil {
+			return nil, err
+		}
+		var recordKeys []string
+		for _, key := range keys {
+			if !strings.HasPrefix(key, r.claimKey("")) {
+				recordKeys = append(recordKeys, key)
+			}
+		}
+		if len(recordKeys) > 0 {
+			// one round trip per SCAN batch rather than per record.
+			values, err := r.Client.MGet(r.Ctx, recordKeys...).Result()
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range values {
+				data, ok := v.(string)
+				if !ok {
+					// deleted between SCAN and MGET
+					continue
+				}
+				var rec repository.Record
+				if err := json.Unmarshal([]byte(data), &rec); err != nil {
+					return nil, err
+				}
+				if filter.Match(rec) {
+					records = append(records, rec)
+				}
+			}
+		}
+		if next == 0 {
+			return records, nil
+		}
+		cursor = next
+	}
+}
+
+// claimKey returns the Redis key of the claim on key, under the key prefix
+// but apart from task records.
+func (r *Redis) claimKey(key string) string {
+	return r.KeyPrefix + keyClaimPrefix + key
+}
+
+// ClaimKey claims key for id, unless an unexpired claim holds it, and returns
+// the id holding the key. The claim is SET NX with ttl as its expiry, so Redis
+// expires it and only one client claims a key at a time.
+func (r *Redis) ClaimKey(key, id string, ttl time.Duration) (string, error) {
+	data, err := json.Marshal(repository.KeyClaim{ID: id})
+	if err != nil {
+		return "", err
+	}
+	ck := r.claimKey(key)
+	// a claim that expires between the SET and the GET is claimed on the next attempt.
+	for attempt := 0; attempt < maxClaimAttempts; attempt++ {
+		claimed, err := r.Client.SetNX(r.Ctx, ck, data, ttl).Result()
+		if err != nil {
+			return "", err
+		}
+		if claimed {
+			return id, nil
+		}
+		stored, err := r.Client.Get(r.Ctx, ck).Bytes()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		var claim repository.KeyClaim
+		if err := json.Unmarshal(stored, &claim); err != nil {
+			return "", err
+		}
+		return claim.ID, nil
+	}
+	return "", fmt.Errorf("key %v kept expiring while it was claimed", key)
+}
+
+// ReleaseKey drops the claim on key, if id holds it.
+func (r *Redis) ReleaseKey(key, id string) error {
+	ck := r.claimKey(key)
+	err := r.Client.Watch(r.Ctx, func(tx *redis.Tx) error {
+		stored, err := tx.Get(r.Ctx, ck).Bytes()
+		if errors.Is(err, redis.Nil) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var claim repository.KeyClaim
+		if err := json.Unmarshal(stored, &claim); err != nil {
+			return err
+		}
+		if claim.ID != id {
+			return nil
+		}
+		_, err = tx.TxPipelined(r.Ctx, func(pipe redis.Pipeliner) error {
+			pipe.Del(r.Ctx, ck)
+			return nil
+		})
+		return err
+	}, ck)
+	if errors.Is(err, redis.TxFailedErr) {
+		return fmt.Errorf("key %v was claimed by another client while it was released", key)
+	}
+	return err
+}
@@ -0,0 +1,212 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-test/deep"
+	"github.com/tinkerbell/pbnj/pkg/repository"
+)
+
+func newTestRedis(t *testing.T, addr string) *Redis {
+	t.Helper()
+	repo, err := NewRedis(context.Background(), RedisOptions{Addr: addr})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { repo.Close() })
+	return repo
+}
+
+func TestRedisAllMethods(t *testing.T) {
+	id := "1234567"
+	srv := miniredis.RunT(t)
+	repo := newTestRedis(t, srv.Addr())
+	record := repository.Record{
+		ID:          id,
+		Description: "test record",
+		Error:       &repository.Error{},
+		State:       "running",
+		Messages:    []string{"connecting to BMC"},
+	}
+	err := repo.Create(id, record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !srv.Exists(DefaultRedisKeyPrefix + id) {
+		t.Fatalf("expected key %v to exist, got: %v", DefaultRedisKeyPrefix+id, srv.Keys())
+	}
+
+	result, err := repo.Get(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := deep.Equal(record, result); diff != nil {
+		t.Fatal(diff)
+	}
+
+	// update record
+	result.Complete = true
+	result.Result = "did a good thing"
+	result.State = "complete"
+	err = repo.Update(id, result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result.Version++
+
+	updatedResult, err := repo.Get(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := deep.Equal(result, updatedResult); diff != nil {
+		t.Fatal(diff)
+	}
+
+	records, err := repo.List(repository.Filter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := deep.Equal([]repository.Record{updatedResult}, records); diff != nil {
+		t.Fatal(diff)
+	}
+
+	// delete record
+	err = repo.Delete(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if srv.Exists(DefaultRedisKeyPrefix + id) {
+		t.Fatal("expected record to be deleted")
+	}
+}
+
+func TestRedisListBatches(t *testing.T) {
+	srv := miniredis.RunT(t)
+	repo := newTestRedis(t, srv.Addr())
+	// more records than a SCAN batch, with claimed keys among them.
+	for i := 0; i < 250; i++ {
+		id := fmt.Sprintf("task-%v", i)
+		host := "10.1.1.1"
+		if i%2 == 1 {
+			host = "10.2.2.2"
+		}
+		if err := repo.Create(id, repository.Record{ID: id, Host: host}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := repo.ClaimKey(id, id, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	records, err := repo.List(repository.Filter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 250 {
+		t.Fatalf("expected every record, got: %v", len(records))
+	}
+	records, err = repo.List(repository.Filter{Host: "10.2.2.2:623"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 125 {
+		t.Fatalf("expected the records of the host, got: %v", len(records))
+	}
+}
+
+func TestRedisSharedBetweenReplicas(t *testing.T) {
+	id := "1234567"
+	srv := miniredis.RunT(t)
+	replicaA := newTestRedis(t, srv.Addr())
+	replicaB := newTestRedis(t, srv.Addr())
+
+	record := repository.Record{ID: id, Description: "power action: POWER_ACTION_ON", State: "running"}
+	if err := replicaA.Create(id, record); err != nil {
+		t.Fatal(err)
+	}
+
+	record.State = "complete"
+	record.Complete = true
+	if err := replicaA.Update(id, record); err != nil {
+		t.Fatal(err)
+	}
+	record.Version++
+
+	result, err := replicaB.Get(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := deep.Equal(record, result); diff != nil {
+		t.Fatal(diff)
+	}
+}
+
+func TestRedisUpdateConflict(t *testing.T) {
+	srv := miniredis.RunT(t)
+	testUpdateConflict(t, newTestRedis(t, srv.Addr()))
+}
+
+func TestRedisDelete(t *testing.T) {
+	srv := miniredis.RunT(t)
+	testDelete(t, newTestRedis(t, srv.Addr()))
+}
+
+func TestRedisClaimKey(t *testing.T) {
+	srv := miniredis.RunT(t)
+	testClaimKey(t, newTestRedis(t, srv.Addr()), srv.FastForward)
+}
+
+func TestRedisRecordNotFound(t *testing.T) {
+	id := "123"
+	expectedError := fmt.Sprintf("record id not found: %v", id)
+	srv := miniredis.RunT(t)
+	repo := newTestRedis(t, srv.Addr())
+
+	_, err := repo.Get(id)
+	if err == nil {
+		t.Fatalf("expecting NON nil error")
+	}
+	if !strings.Contains(err.Error(), expectedError) {
+		t.Fatalf("expected: %v, got: %v", expectedError, err.Error())
+	}
+
+	err = repo.Update(id, repository.Record{})
+	if err == nil {
+		t.Fatalf("expecting NON nil error")
+	}
+	if !strings.Contains(err.Error(), expectedError) {
+		t.Fatalf("expected: %v, got: %v", expectedError, err.Error())
+	}
+	if srv.Exists(DefaultRedisKeyPrefix + id) {
+		t.Fatal("update of a missing record must not create it")
+	}
+}
+
+func TestRedisConnectionError(t *testing.T) {
+	srv := miniredis.RunT(t)
+	addr := srv.Addr()
+	srv.Close()
+
+	_, err := NewRedis(context.Background(), RedisOptions{Addr: addr})
+	if err == nil {
+		t.Fatalf("expecting NON nil error")
+	}
+	expectedError := "unable to connect to redis at " + addr
+	if !strings.Contains(err.Error(), expectedError) {
+		t.Fatalf("expected: %v, got: %v", expectedError, err.Error())
+	}
+}
+
+func TestRedisTLSConfig(t *testing.T) {
+	_, err := NewRedis(context.Background(), RedisOptions{Addr: "127.0.0.1:0", TLS: true, TLSCAFile: "/does/not/exist.pem"})
+	if err == nil {
+		t.Fatalf("expecting NON nil error")
+	}
+	expectedError := "unable to read redis CA file"
+	if !strings.Contains(err.Error(), expectedError) {
+		t.Fatalf("expected: %v, got: %v", expectedError, err.Error())
+	}
+}
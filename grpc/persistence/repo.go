@@ -3,6 +3,8 @@ package persistence
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/philippgille/gokv"
 	"github.com/tinkerbell/pbnj/pkg/repository"
@@ -12,11 +14,30 @@ import (
 type GoKV struct {
 	Ctx   context.Context
 	Store gokv.Store
+
+	// updateMu makes the version check and write of Update atomic. It only
+	// guards writers using this GoKV; gokv.Store has no compare-and-swap, so
+	// replicas sharing a store should use the Redis or Bolt stores instead.
+	updateMu sync.Mutex
+
+	// gokv.Store has no way to enumerate keys, so the IDs of
+	// records created through this GoKV are tracked for List.
+	idsMu sync.Mutex
+	ids   map[string]struct{}
 }
 
 // Create a record.
 func (g *GoKV) Create(id string, val repository.Record) error {
-	return g.Store.Set(id, val)
+	if err := g.Store.Set(id, val); err != nil {
+		return err
+	}
+	g.idsMu.Lock()
+	defer g.idsMu.Unlock()
+	if g.ids == nil {
+		g.ids = make(map[string]struct{})
+	}
+	g.ids[id] = struct{}{}
+	return nil
 }
 
 // Get a record.
@@ -27,25 +48,113 @@ func (g *GoKV) Get(id string) (repository.Record, error) {
 		return *rec, err
 	}
 	if !found {
-		err = fmt.Errorf("record id not found: %v", id)
+		err = fmt.Errorf("%w: %v", repository.ErrNotFound, id)
 	}
 	return *rec, err
 }
 
-// Update a record.
+// Update a record, if the stored record has the same version.
 func (g *GoKV) Update(id string, val repository.Record) error {
+	g.updateMu.Lock()
+	defer g.updateMu.Unlock()
 	rec := new(repository.Record)
 	found, err := g.Store.Get(id, rec)
 	if err != nil {
 		return err
 	}
 	if !found {
-		return fmt.Errorf("record id not found: %v", id)
+		return fmt.Errorf("%w: %v", repository.ErrNotFound, id)
 	}
+	if rec.Version != val.Version {
+		return fmt.Errorf("%w: record %v is at version %v, not %v", repository.ErrConflict, id, rec.Version, val.Version)
+	}
+	val.Version++
 	return g.Store.Set(id, val)
 }
 
-// Delete a record.
+// Delete a record, if it exists.
 func (g *GoKV) Delete(id string) error {
-	return g.Store.Delete(id)
+	g.updateMu.Lock()
+	defer g.updateMu.Unlock()
+	found, err := g.Store.Get(id, new(repository.Record))
+	if err != nil {
+		return err
+	}
+	if found {
+		if err := g.Store.Delete(id); err != nil {
+			return err
+		}
+	}
+	g.idsMu.Lock()
+	delete(g.ids, id)
+	g.idsMu.Unlock()
+	if !found {
+		return fmt.Errorf("%w: %v", repository.ErrNotFound, id)
+	}
+	return nil
+}
+
+// List the records created through this store that match the filter.
+// Records the underlying store has evicted are skipped.
+func (g *GoKV) List(filter repository.Filter) ([]repository.Record, error) {
+	g.idsMu.Lock()
+	ids := make([]string, 0, len(g.ids))
+	for id := range g.ids {
+		ids = append(ids, id)
+	}
+	g.idsMu.Unlock()
+
+	records := make([]repository.Record, 0, len(ids))
+	for _, id := range ids {
+		rec := new(repository.Record)
+		found, err := g.Store.Get(id, rec)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			g.idsMu.Lock()
+			delete(g.ids, id)
+			g.idsMu.Unlock()
+			continue
+		}
+		if filter.Match(*rec) {
+			records = append(records, *rec)
+		}
+	}
+	return records, nil
+}
+
+// keyClaimPrefix namespaces key claims in the store, apart from task records.
+const keyClaimPrefix = "idempotency-key:"
+
+// ClaimKey claims key for id, unless an unexpired claim holds it, and returns
+// the id holding the key. The claim's deadline is stored with it.
+func (g *GoKV) ClaimKey(key, id string, ttl time.Duration) (string, error) {
+	g.updateMu.Lock()
+	defer g.updateMu.Unlock()
+	claim := new(repository.KeyClaim)
+	if _, err := g.Store.Get(keyClaimPrefix+key, claim); err != nil {
+		return "", err
+	}
+	if claim.Held(time.Now()) {
+		return claim.ID, nil
+	}
+	if err := g.Store.Set(keyClaimPrefix+key, repository.NewKeyClaim(id, ttl)); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// ReleaseKey drops the claim on key, if id holds it.
+func (g *GoKV) ReleaseKey(key, id string) error {
+	g.updateMu.Lock()
+	defer g.updateMu.Unlock()
+	claim := new(repository.KeyClaim)
+	if _, err := g.Store.Get(keyClaimPrefix+key, claim); err != nil {
+		return err
+	}
+	if claim.ID != id {
+		return nil
+	}
+	return g.Store.Delete(keyClaimPrefix + key)
 }
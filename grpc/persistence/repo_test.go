@@ -2,9 +2,12 @@ package persistence
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-test/deep"
 	"github.com/philippgille/gokv"
@@ -42,6 +45,7 @@ func TestAllMethods(t *testing.T) {
 		State:       "complete",
 		Result:      "did a good thing",
 		Complete:    true,
+		Version:     1,
 	}
 	err := repo.Create(id, record)
 	if err != nil {
@@ -143,3 +147,202 @@ func TestUpdateRecordError(t *testing.T) {
 		t.Fatalf("expected: %v, got: %v", expectedError, err.Error())
 	}
 }
+
+// testUpdateConflict checks that an update based on a stale record fails and
+// leaves the stored record as it was.
+func testUpdateConflict(t *testing.T, repo repository.Actions) {
+	t.Helper()
+	id := "1234567"
+	if err := repo.Create(id, repository.Record{ID: id, State: "running"}); err != nil {
+		t.Fatal(err)
+	}
+	a, err := repo.Get(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := a
+
+	a.Messages = []string{"from a"}
+	if err := repo.Update(id, a); err != nil {
+		t.Fatal(err)
+	}
+	b.State = "complete"
+	if err := repo.Update(id, b); !errors.Is(err, repository.ErrConflict) {
+		t.Fatalf("expected a conflict, got: %v", err)
+	}
+
+	stored, err := repo.Get(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Version != 1 || stored.State != "running" || len(stored.Messages) != 1 {
+		t.Fatalf("expected the first update to be kept, got: %+v", stored)
+	}
+	stored.State = "complete"
+	if err := repo.Update(id, stored); err != nil {
+		t.Fatalf("expected an update of the current version to succeed, got: %v", err)
+	}
+}
+
+func TestUpdateConflict(t *testing.T) {
+	f := freecache.NewStore(freecache.DefaultOptions)
+	defer f.Close()
+	testUpdateConflict(t, &GoKV{Store: f, Ctx: context.Background()})
+}
+
+// testDelete checks that only the first of two deletes of a record succeeds.
+func testDelete(t *testing.T, repo repository.Actions) {
+	t.Helper()
+	id := "1234567"
+	if err := repo.Create(id, repository.Record{ID: id}); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Delete(id); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Delete(id); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("expected %v, got: %v", repository.ErrNotFound, err)
+	}
+	if _, err := repo.Get(id); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("expected %v, got: %v", repository.ErrNotFound, err)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	f := freecache.NewStore(freecache.DefaultOptions)
+	defer f.Close()
+	testDelete(t, &GoKV{Store: f, Ctx: context.Background()})
+}
+
+// testClaimKey checks that a key is held by its first claim until it is released
+// or the claim expires, and that claims are not listed as records.
+func testClaimKey(t *testing.T, repo repository.Actions, wait func(time.Duration)) {
+	t.Helper()
+	if id, err := repo.ClaimKey("key", "first", 0); err != nil || id != "first" {
+		t.Fatalf("expected the key to be claimed, got: %v, %v", id, err)
+	}
+	if id, err := repo.ClaimKey("key", "second", 0); err != nil || id != "first" {
+		t.Fatalf("expected the first claim to hold the key, got: %v, %v", id, err)
+	}
+	if id, err := repo.ClaimKey("other", "second", 0); err != nil || id != "second" {
+		t.Fatalf("expected another key to be claimed, got: %v, %v", id, err)
+	}
+	if records, err := repo.List(repository.Filter{}); err != nil || len(records) != 0 {
+		t.Fatalf("expected no records, got: %v, %v", records, err)
+	}
+
+	// only the holder releases a claim.
+	if err := repo.ReleaseKey("key", "second"); err != nil {
+		t.Fatal(err)
+	}
+	if id, err := repo.ClaimKey("key", "third", 0); err != nil || id != "first" {
+		t.Fatalf("expected the first claim to hold the key, got: %v, %v", id, err)
+	}
+	if err := repo.ReleaseKey("key", "first"); err != nil {
+		t.Fatal(err)
+	}
+	if id, err := repo.ClaimKey("key", "third", 0); err != nil || id != "third" {
+		t.Fatalf("expected a released key to be claimed again, got: %v, %v", id, err)
+	}
+
+	// expired claims no longer hold the key, without being released.
+	if id, err := repo.ClaimKey("expiring", "first", 50*time.Millisecond); err != nil || id != "first" {
+		t.Fatalf("expected the key to be claimed, got: %v, %v", id, err)
+	}
+	if id, err := repo.ClaimKey("expiring", "second", 50*time.Millisecond); err != nil || id != "first" {
+		t.Fatalf("expected the unexpired claim to hold the key, got: %v, %v", id, err)
+	}
+	wait(100 * time.Millisecond)
+	if id, err := repo.ClaimKey("expiring", "second", 50*time.Millisecond); err != nil || id != "second" {
+		t.Fatalf("expected an expired claim to be replaced, got: %v, %v", id, err)
+	}
+	if err := repo.ReleaseKey("missing", "first"); err != nil {
+		t.Fatalf("expected releasing an unclaimed key to succeed, got: %v", err)
+	}
+}
+
+func TestClaimKey(t *testing.T) {
+	f := freecache.NewStore(freecache.DefaultOptions)
+	defer f.Close()
+	testClaimKey(t, &GoKV{Store: f, Ctx: context.Background()}, time.Sleep)
+}
+
+func TestList(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	defer s.Close()
+	repo := &GoKV{Store: s, Ctx: ctx}
+	for _, id := range []string{"1", "2", "3"} {
+		if err := repo.Create(id, repository.Record{ID: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := repo.Delete("2"); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := repo.List(repository.Filter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, rec := range records {
+		ids = append(ids, rec.ID)
+	}
+	sort.Strings(ids)
+	if diff := deep.Equal([]string{"1", "3"}, ids); diff != nil {
+		t.Fatal(diff)
+	}
+}
+
+func TestListFilter(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	defer s.Close()
+	repo := &GoKV{Store: s, Ctx: ctx}
+	now := time.Now().UTC()
+	for _, rec := range []repository.Record{
+		{ID: "1", Description: "power action: on", State: "running", Host: "10.1.1.1", CreatedAt: now.Add(-3 * time.Hour)},
+		{ID: "2", Description: "power action: off", State: "complete", Complete: true, Host: "10.1.1.1", CreatedAt: now.Add(-2 * time.Hour)},
+		{ID: "3", Description: "setting boot device: pxe", State: "running", Host: "10.2.2.2", CreatedAt: now.Add(-1 * time.Hour), IdempotencyKey: "abc"},
+	} {
+		if err := repo.Create(rec.ID, rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	complete := true
+	testCases := map[string]struct {
+		filter repository.Filter
+		want   []string
+	}{
+		"no filter":         {filter: repository.Filter{}, want: []string{"1", "2", "3"}},
+		"state":             {filter: repository.Filter{States: []string{"running"}}, want: []string{"1", "3"}},
+		"complete":          {filter: repository.Filter{Complete: &complete}, want: []string{"2"}},
+		"host":              {filter: repository.Filter{Host: "10.1.1.1"}, want: []string{"1", "2"}},
+		"host and state":    {filter: repository.Filter{Host: "10.1.1.1", States: []string{"running"}}, want: []string{"1"}},
+		"description":       {filter: repository.Filter{Description: "POWER"}, want: []string{"1", "2"}},
+		"created after":     {filter: repository.Filter{CreatedAfter: now.Add(-150 * time.Minute)}, want: []string{"2", "3"}},
+		"created before":    {filter: repository.Filter{CreatedBefore: now.Add(-150 * time.Minute)}, want: []string{"1"}},
+		"created in window": {filter: repository.Filter{CreatedAfter: now.Add(-150 * time.Minute), CreatedBefore: now.Add(-90 * time.Minute)}, want: []string{"2"}},
+		"idempotency key":   {filter: repository.Filter{IdempotencyKey: "abc"}, want: []string{"3"}},
+		"nothing matches":   {filter: repository.Filter{Host: "10.3.3.3"}, want: nil},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			records, err := repo.List(tc.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ids []string
+			for _, rec := range records {
+				ids = append(ids, rec.ID)
+			}
+			sort.Strings(ids)
+			if diff := deep.Equal(tc.want, ids); diff != nil {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
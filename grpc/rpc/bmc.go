@@ -2,15 +2,20 @@ package rpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/rs/xid"
 	v1 "github.com/tinkerbell/pbnj/api/v1"
 	"github.com/tinkerbell/pbnj/grpc/oob/bmc"
+	"github.com/tinkerbell/pbnj/grpc/taskrunner"
 	"github.com/tinkerbell/pbnj/pkg/logging"
+	"github.com/tinkerbell/pbnj/pkg/repository"
 	"github.com/tinkerbell/pbnj/pkg/task"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // BmcService for doing BMC actions.
@@ -20,6 +25,8 @@ type BmcService struct {
 	// TaskRunner.Execute function that runs all BMC
 	// interactions in the background.
 	Timeout time.Duration
+	// MaxTimeout caps the timeout a request can ask for, zero means no cap.
+	MaxTimeout time.Duration
 	// SkipRedfishVersions is a list of Redfish versions to be ignored,
 	//
 	// When running an action on a BMC, PBnJ will pass the value of the skipRedfishVersions to bmclib
@@ -29,12 +36,77 @@ type BmcService struct {
 	// for more information see https://github.com/bmc-toolbox/bmclib#bmc-connections
 	SkipRedfishVersions []string
 	TaskRunner          task.Task
+	// Callbacks restricts the callback URLs of task requests.
+	Callbacks CallbackPolicy
 	v1.UnimplementedBMCServer
 }
 
-// NetworkSource sets the BMC network source.
-func (b *BmcService) NetworkSource(_ context.Context, _ *v1.NetworkSourceRequest) (*v1.NetworkSourceResponse, error) {
-	return nil, errors.New("not implemented")
+// Rerun kinds of the service's tasks.
+const (
+	CreateUserRerun       = "create_user"
+	DeactivateSOLRerun    = "deactivate_sol"
+	DeleteUserRerun       = "delete_user"
+	NetworkSourceRerun    = "network_source"
+	ResetRerun            = "reset"
+	SetNetworkConfigRerun = "set_network_config"
+	UpdateUserRerun       = "update_user"
+)
+
+// Reruns returns the functions that rebuild the actions of the service's tasks, keyed by rerun kind.
+func (b *BmcService) Reruns() map[string]taskrunner.RerunFunc {
+	return map[string]taskrunner.RerunFunc{
+		CreateUserRerun:       rerunFunc(b.createUserAction),
+		DeactivateSOLRerun:    rerunFunc(b.deactivateSOLAction),
+		DeleteUserRerun:       rerunFunc(b.deleteUserAction),
+		NetworkSourceRerun:    rerunFunc(b.networkSourceAction),
+		ResetRerun:            rerunFunc(b.resetAction),
+		SetNetworkConfigRerun: rerunFunc(b.setNetworkConfigAction),
+		UpdateUserRerun:       rerunFunc(b.updateUserAction),
+	}
+}
+
+// NetworkSource switches the BMC's own network configuration between DHCP and static.
+func (b *BmcService) NetworkSource(ctx context.Context, in *v1.NetworkSourceRequest) (*v1.NetworkSourceResponse, error) {
+	l := logging.ExtractLogr(ctx)
+	taskID := xid.New().String()
+	l = l.WithValues("taskID", taskID)
+
+	l.Info(
+		"start NetworkSource request",
+		"username", in.Authn.GetDirectAuthn().GetUsername(),
+		"vendor", in.Vendor.GetName(),
+		"networkSource", in.GetNetworkSource().String(),
+	)
+
+	opts, err := taskOptions(ctx, b.Callbacks, NetworkSourceRerun, in)
+	if err != nil {
+		return nil, err
+	}
+	taskID = b.TaskRunner.Execute(ctx, l, "setting bmc network source", taskID, b.networkSourceAction(ctx, l, in), opts...)
+
+	return &v1.NetworkSourceResponse{TaskId: taskID}, nil
+}
+
+// networkSourceAction returns the task action that sets the network source of the request.
+func (b *BmcService) networkSourceAction(ctx context.Context, l logr.Logger, in *v1.NetworkSourceRequest) func(context.Context, chan repository.StatusMessage) (task.Result, error) {
+	return func(runCtx context.Context, s chan repository.StatusMessage) (task.Result, error) {
+		t, err := bmc.NewBMCResetter(
+			bmc.WithLogger(l),
+			bmc.WithStatusMessage(s),
+			bmc.WithNetworkSourceRequest(in),
+			bmc.WithSkipRedfishVersions(b.SkipRedfishVersions),
+		)
+		if err != nil {
+			return task.Result{}, err
+		}
+		// Because this is a background task, we want to pass through the span context, but not be
+		// a child context of the request. This allows us to correctly plumb otel into the background task.
+		// runCtx is cancelled by the TaskRunner when the task is cancelled.
+		c := trace.ContextWithSpanContext(runCtx, trace.SpanContextFromContext(ctx))
+		taskCtx, cancel := context.WithTimeout(c, taskTimeout(b.Timeout, b.MaxTimeout, in))
+		defer cancel()
+		return t.NetworkSource(taskCtx)
+	}
 }
 
 // Reset calls a reset on a BMC.
@@ -50,25 +122,34 @@ func (b *BmcService) Reset(ctx context.Context, in *v1.ResetRequest) (*v1.ResetR
 		"resetKind", in.GetResetKind().String(),
 	)
 
-	execFunc := func(s chan string) (string, error) {
+	opts, err := taskOptions(ctx, b.Callbacks, ResetRerun, in)
+	if err != nil {
+		return nil, err
+	}
+	taskID = b.TaskRunner.Execute(ctx, l, "bmc reset", taskID, b.resetAction(ctx, l, in), opts...)
+
+	return &v1.ResetResponse{TaskId: taskID}, nil
+}
+
+// resetAction returns the task action that does the reset request.
+func (b *BmcService) resetAction(ctx context.Context, l logr.Logger, in *v1.ResetRequest) func(context.Context, chan repository.StatusMessage) (task.Result, error) {
+	return func(runCtx context.Context, s chan repository.StatusMessage) (task.Result, error) {
 		t, err := bmc.NewBMCResetter(
 			bmc.WithLogger(l),
 			bmc.WithStatusMessage(s),
 			bmc.WithResetRequest(in),
 		)
 		if err != nil {
-			return "", err
+			return task.Result{}, err
 		}
 		// Because this is a background task, we want to pass through the span context, but not be
-		// a child context. This allows us to correctly plumb otel into the background task.
-		c := trace.ContextWithSpanContext(context.Background(), trace.SpanContextFromContext(ctx))
-		taskCtx, cancel := context.WithTimeout(c, b.Timeout)
+		// a child context of the request. This allows us to correctly plumb otel into the background task.
+		// runCtx is cancelled by the TaskRunner when the task is cancelled.
+		c := trace.ContextWithSpanContext(runCtx, trace.SpanContextFromContext(ctx))
+		taskCtx, cancel := context.WithTimeout(c, taskTimeout(b.Timeout, b.MaxTimeout, in))
 		defer cancel()
-		return "", t.BMCReset(taskCtx, in.ResetKind.String())
+		return t.BMCReset(taskCtx, in.ResetKind.String())
 	}
-	b.TaskRunner.Execute(ctx, l, "bmc reset", taskID, execFunc)
-
-	return &v1.ResetResponse{TaskId: taskID}, nil
 }
 
 // DeactivateSOL deactivates any active SOL session on the BMC.
@@ -82,25 +163,134 @@ func (b *BmcService) DeactivateSOL(ctx context.Context, in *v1.DeactivateSOLRequ
 		"vendor", in.Vendor.GetName(),
 	)
 
-	execFunc := func(s chan string) (string, error) {
+	opts, err := taskOptions(ctx, b.Callbacks, DeactivateSOLRerun, in)
+	if err != nil {
+		return nil, err
+	}
+	taskID = b.TaskRunner.Execute(ctx, l, "deactivating SOL session", taskID, b.deactivateSOLAction(ctx, l, in), opts...)
+
+	return &v1.DeactivateSOLResponse{TaskId: taskID}, nil
+}
+
+// deactivateSOLAction returns the task action that deactivates the SOL session of the request.
+func (b *BmcService) deactivateSOLAction(ctx context.Context, l logr.Logger, in *v1.DeactivateSOLRequest) func(context.Context, chan repository.StatusMessage) (task.Result, error) {
+	return func(runCtx context.Context, s chan repository.StatusMessage) (task.Result, error) {
 		t, err := bmc.NewBMCResetter(
 			bmc.WithDeactivateSOLRequest(in),
 			bmc.WithLogger(l),
 			bmc.WithStatusMessage(s),
 		)
 		if err != nil {
-			return "", err
+			return task.Result{}, err
 		}
 		// Because this is a background task, we want to pass through the span context, but not be
-		// a child context. This allows us to correctly plumb otel into the background task.
-		c := trace.ContextWithSpanContext(context.Background(), trace.SpanContextFromContext(ctx))
-		taskCtx, cancel := context.WithTimeout(c, b.Timeout)
+		// a child context of the request. This allows us to correctly plumb otel into the background task.
+		// runCtx is cancelled by the TaskRunner when the task is cancelled.
+		c := trace.ContextWithSpanContext(runCtx, trace.SpanContextFromContext(ctx))
+		taskCtx, cancel := context.WithTimeout(c, taskTimeout(b.Timeout, b.MaxTimeout, in))
 		defer cancel()
-		return "", t.DeactivateSOL(taskCtx)
+		return t.DeactivateSOL(taskCtx)
 	}
-	b.TaskRunner.Execute(ctx, l, "deactivating SOL session", taskID, execFunc)
+}
 
-	return &v1.DeactivateSOLResponse{TaskId: taskID}, nil
+// GetNetworkConfig reads the configuration of the BMC's own network interface.
+func (b *BmcService) GetNetworkConfig(ctx context.Context, in *v1.GetNetworkConfigRequest) (*v1.GetNetworkConfigResponse, error) {
+	l := logging.ExtractLogr(ctx)
+	l = l.WithValues("bmcIP", in.Authn.GetDirectAuthn().GetHost().GetHost())
+
+	l.Info(
+		"start GetNetworkConfig request",
+		"username", in.Authn.GetDirectAuthn().GetUsername(),
+		"vendor", in.Vendor.GetName(),
+	)
+
+	t, err := bmc.NewBMCResetter(
+		bmc.WithLogger(l),
+		bmc.WithGetNetworkConfigRequest(in),
+		bmc.WithSkipRedfishVersions(b.SkipRedfishVersions),
+	)
+	if err != nil {
+		return nil, bmcError(err)
+	}
+	// unlike the other BMC calls this one isn't a task, but it is bounded by the same timeout.
+	bmcCtx, cancel := context.WithTimeout(ctx, b.Timeout)
+	defer cancel()
+	cfg, err := t.GetNetworkConfig(bmcCtx)
+	if err != nil {
+		l.Error(err, "error getting network config")
+		return nil, bmcError(err)
+	}
+
+	return &v1.GetNetworkConfigResponse{NetworkConfig: cfg}, nil
+}
+
+// SetNetworkConfig sets the configuration of the BMC's own network interface. The task
+// only completes once the BMC is reachable at its new address and shows the settings applied.
+func (b *BmcService) SetNetworkConfig(ctx context.Context, in *v1.SetNetworkConfigRequest) (*v1.SetNetworkConfigResponse, error) {
+	l := logging.ExtractLogr(ctx)
+	taskID := xid.New().String()
+	l = l.WithValues("taskID", taskID)
+
+	l.Info(
+		"start SetNetworkConfig request",
+		"username", in.Authn.GetDirectAuthn().GetUsername(),
+		"vendor", in.Vendor.GetName(),
+		"networkSource", in.GetNetworkConfig().GetNetworkSource().String(),
+		"address", in.GetNetworkConfig().GetAddress(),
+	)
+
+	if _, err := networkConfigTimeout(b.Timeout, b.MaxTimeout, in); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	opts, err := taskOptions(ctx, b.Callbacks, SetNetworkConfigRerun, in)
+	if err != nil {
+		return nil, err
+	}
+	taskID = b.TaskRunner.Execute(ctx, l, "setting bmc network config", taskID, b.setNetworkConfigAction(ctx, l, in), opts...)
+
+	return &v1.SetNetworkConfigResponse{TaskId: taskID}, nil
+}
+
+// setNetworkConfigAction returns the task action that sets the network config of the request.
+func (b *BmcService) setNetworkConfigAction(ctx context.Context, l logr.Logger, in *v1.SetNetworkConfigRequest) func(context.Context, chan repository.StatusMessage) (task.Result, error) {
+	return func(runCtx context.Context, s chan repository.StatusMessage) (task.Result, error) {
+		t, err := bmc.NewBMCResetter(
+			bmc.WithLogger(l),
+			bmc.WithStatusMessage(s),
+			bmc.WithSetNetworkConfigRequest(in),
+			bmc.WithSkipRedfishVersions(b.SkipRedfishVersions),
+		)
+		if err != nil {
+			return task.Result{}, err
+		}
+		timeout, err := networkConfigTimeout(b.Timeout, b.MaxTimeout, in)
+		if err != nil {
+			return task.Result{}, err
+		}
+		// Because this is a background task, we want to pass through the span context, but not be
+		// a child context of the request. This allows us to correctly plumb otel into the background task.
+		// runCtx is cancelled by the TaskRunner when the task is cancelled.
+		// The BMC may take a while to come back at its new address, which the timeout leaves time for.
+		c := trace.ContextWithSpanContext(runCtx, trace.SpanContextFromContext(ctx))
+		taskCtx, cancel := context.WithTimeout(c, timeout)
+		defer cancel()
+		return t.SetNetworkConfig(taskCtx)
+	}
+}
+
+// networkConfigTimeout returns the timeout of a SetNetworkConfig task, which is the time
+// setting the configuration may take plus the time the BMC has to become reachable at its
+// new address. A verify_timeout_ms that makes it exceed maxTimeout, when one is set, is an
+// error rather than cut short. Without one, the default verify time is lowered to fit.
+func networkConfigTimeout(def, maxTimeout time.Duration, in *v1.SetNetworkConfigRequest) (time.Duration, error) {
+	t := taskTimeout(def, maxTimeout, in) + bmc.VerifyTimeout(in)
+	if maxTimeout > 0 && t > maxTimeout {
+		if in.GetVerifyTimeoutMs() > 0 {
+			return 0, fmt.Errorf("verify_timeout_ms plus the task timeout is %v, more than the maximum timeout of %v", t, maxTimeout)
+		}
+		return maxTimeout, nil
+	}
+	return t, nil
 }
 
 // CreateUser sets the next boot device of a machine.
@@ -117,25 +307,37 @@ func (b *BmcService) CreateUser(ctx context.Context, in *v1.CreateUserRequest) (
 		"userCreds.UserRole", in.UserCreds.UserRole,
 	)
 
-	execFunc := func(s chan string) (string, error) {
+	opts, err := taskOptions(ctx, b.Callbacks, CreateUserRerun, in)
+	if err != nil {
+		return nil, err
+	}
+	taskID = b.TaskRunner.Execute(ctx, l, "creating user", taskID, b.createUserAction(ctx, l, in), opts...)
+
+	return &v1.CreateUserResponse{TaskId: taskID}, nil
+}
+
+// createUserAction returns the task action that creates the user of the request.
+func (b *BmcService) createUserAction(ctx context.Context, l logr.Logger, in *v1.CreateUserRequest) func(context.Context, chan repository.StatusMessage) (task.Result, error) {
+	return func(runCtx context.Context, s chan repository.StatusMessage) (task.Result, error) {
 		t, err := bmc.NewBMC(
 			bmc.WithCreateUserRequest(in),
 			bmc.WithLogger(l),
 			bmc.WithStatusMessage(s),
 		)
 		if err != nil {
-			return "", err
+			return task.Result{}, err
 		}
 		// Because this is a background task, we want to pass through the span context, but not be
-		// a child context. This allows us to correctly plumb otel into the background task.
-		c := trace.ContextWithSpanContext(context.Background(), trace.SpanContextFromContext(ctx))
-		taskCtx, cancel := context.WithTimeout(c, b.Timeout)
+		// a child context of the request. This allows us to correctly plumb otel into the background task.
+		// runCtx is cancelled by the TaskRunner when the task is cancelled.
+		c := trace.ContextWithSpanContext(runCtx, trace.SpanContextFromContext(ctx))
+		taskCtx, cancel := context.WithTimeout(c, taskTimeout(b.Timeout, b.MaxTimeout, in))
 		defer cancel()
-		return "", t.CreateUser(taskCtx)
+		if err := t.CreateUser(taskCtx); err != nil {
+			return task.Result{}, err
+		}
+		return bmc.UserResult(in.UserCreds.GetUsername(), v1.UserResult_OPERATION_CREATE), nil
 	}
-	b.TaskRunner.Execute(ctx, l, "creating user", taskID, execFunc)
-
-	return &v1.CreateUserResponse{TaskId: taskID}, nil
 }
 
 // UpdateUser updates a users credentials on a BMC.
@@ -152,25 +354,37 @@ func (b *BmcService) UpdateUser(ctx context.Context, in *v1.UpdateUserRequest) (
 		"userCreds.UserRole", in.UserCreds.UserRole,
 	)
 
-	execFunc := func(s chan string) (string, error) {
+	opts, err := taskOptions(ctx, b.Callbacks, UpdateUserRerun, in)
+	if err != nil {
+		return nil, err
+	}
+	taskID = b.TaskRunner.Execute(ctx, l, "updating user", taskID, b.updateUserAction(ctx, l, in), opts...)
+
+	return &v1.UpdateUserResponse{TaskId: taskID}, nil
+}
+
+// updateUserAction returns the task action that updates the user of the request.
+func (b *BmcService) updateUserAction(ctx context.Context, l logr.Logger, in *v1.UpdateUserRequest) func(context.Context, chan repository.StatusMessage) (task.Result, error) {
+	return func(runCtx context.Context, s chan repository.StatusMessage) (task.Result, error) {
 		t, err := bmc.NewBMC(
 			bmc.WithUpdateUserRequest(in),
 			bmc.WithLogger(l),
 			bmc.WithStatusMessage(s),
 		)
 		if err != nil {
-			return "", err
+			return task.Result{}, err
 		}
 		// Because this is a background task, we want to pass through the span context, but not be
-		// a child context. This allows us to correctly plumb otel into the background task.
-		c := trace.ContextWithSpanContext(context.Background(), trace.SpanContextFromContext(ctx))
-		taskCtx, cancel := context.WithTimeout(c, b.Timeout)
+		// a child context of the request. This allows us to correctly plumb otel into the background task.
+		// runCtx is cancelled by the TaskRunner when the task is cancelled.
+		c := trace.ContextWithSpanContext(runCtx, trace.SpanContextFromContext(ctx))
+		taskCtx, cancel := context.WithTimeout(c, taskTimeout(b.Timeout, b.MaxTimeout, in))
 		defer cancel()
-		return "", t.UpdateUser(taskCtx)
+		if err := t.UpdateUser(taskCtx); err != nil {
+			return task.Result{}, err
+		}
+		return bmc.UserResult(in.UserCreds.GetUsername(), v1.UserResult_OPERATION_UPDATE), nil
 	}
-	b.TaskRunner.Execute(ctx, l, "updating user", taskID, execFunc)
-
-	return &v1.UpdateUserResponse{TaskId: taskID}, nil
 }
 
 // DeleteUser deletes a user on a BMC.
@@ -185,23 +399,35 @@ func (b *BmcService) DeleteUser(ctx context.Context, in *v1.DeleteUserRequest) (
 		"userCreds.Username", in.Username,
 	)
 
-	execFunc := func(s chan string) (string, error) {
+	opts, err := taskOptions(ctx, b.Callbacks, DeleteUserRerun, in)
+	if err != nil {
+		return nil, err
+	}
+	taskID = b.TaskRunner.Execute(ctx, l, "deleting user", taskID, b.deleteUserAction(ctx, l, in), opts...)
+
+	return &v1.DeleteUserResponse{TaskId: taskID}, nil
+}
+
+// deleteUserAction returns the task action that deletes the user of the request.
+func (b *BmcService) deleteUserAction(ctx context.Context, l logr.Logger, in *v1.DeleteUserRequest) func(context.Context, chan repository.StatusMessage) (task.Result, error) {
+	return func(runCtx context.Context, s chan repository.StatusMessage) (task.Result, error) {
 		t, err := bmc.NewBMC(
 			bmc.WithDeleteUserRequest(in),
 			bmc.WithLogger(l),
 			bmc.WithStatusMessage(s),
 		)
 		if err != nil {
-			return "", err
+			return task.Result{}, err
 		}
 		// Because this is a background task, we want to pass through the span context, but not be
-		// a child context. This allows us to correctly plumb otel into the background task.
-		c := trace.ContextWithSpanContext(context.Background(), trace.SpanContextFromContext(ctx))
-		taskCtx, cancel := context.WithTimeout(c, b.Timeout)
+		// a child context of the request. This allows us to correctly plumb otel into the background task.
+		// runCtx is cancelled by the TaskRunner when the task is cancelled.
+		c := trace.ContextWithSpanContext(runCtx, trace.SpanContextFromContext(ctx))
+		taskCtx, cancel := context.WithTimeout(c, taskTimeout(b.Timeout, b.MaxTimeout, in))
 		defer cancel()
-		return "", t.DeleteUser(taskCtx)
+		if err := t.DeleteUser(taskCtx); err != nil {
+			return task.Result{}, err
+		}
+		return bmc.UserResult(in.GetUsername(), v1.UserResult_OPERATION_DELETE), nil
 	}
-	b.TaskRunner.Execute(ctx, l, "deleting user", taskID, execFunc)
-
-	return &v1.DeleteUserResponse{TaskId: taskID}, nil
 }
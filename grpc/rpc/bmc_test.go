@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/exec"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/philippgille/gokv"
@@ -14,6 +15,8 @@ import (
 	v1 "github.com/tinkerbell/pbnj/api/v1"
 	"github.com/tinkerbell/pbnj/grpc/persistence"
 	"github.com/tinkerbell/pbnj/grpc/taskrunner"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const tempIPMITool = "/tmp/ipmitool"
@@ -68,7 +71,6 @@ func TestConfigNetworkSource(t *testing.T) {
 	testCases := []struct {
 		name        string
 		req         *v1.NetworkSourceRequest
-		message     string
 		expectedErr error
 	}{
 		{
@@ -88,10 +90,8 @@ func TestConfigNetworkSource(t *testing.T) {
 				Vendor: &v1.Vendor{
 					Name: "",
 				},
-				NetworkSource: 0,
+				NetworkSource: v1.NetworkSource_NETWORK_SOURCE_DHCP,
 			},
-			message:     "good",
-			expectedErr: errors.New("not implemented"),
 		},
 	}
 
@@ -99,16 +99,99 @@ func TestConfigNetworkSource(t *testing.T) {
 		testCase := tc
 		t.Run(testCase.name, func(t *testing.T) {
 			response, err := bmcService.NetworkSource(ctx, testCase.req)
-			if response != nil {
-				t.Fatalf("response should be nil, got: %v", response)
+			if err != nil {
+				if diff := cmp.Diff(tc.expectedErr.Error(), err.Error()); diff != "" {
+					t.Fatal(diff)
+				}
+			} else if response.TaskId == "" {
+				t.Fatal("expected taskId, got:", response.TaskId)
+			}
+		})
+	}
+}
+
+func TestSetNetworkConfig(t *testing.T) {
+	in := &v1.SetNetworkConfigRequest{
+		Authn: &v1.Authn{
+			Authn: &v1.Authn_DirectAuthn{
+				DirectAuthn: &v1.DirectAuthn{
+					Host: &v1.Host{
+						Host: "127.0.0.1",
+					},
+					Username: "ADMIN",
+					Password: "ADMIN",
+				},
+			},
+		},
+		NetworkConfig: &v1.NetworkConfig{
+			NetworkSource: v1.NetworkSource_NETWORK_SOURCE_STATIC,
+			Address:       "192.0.2.10",
+			Netmask:       "255.255.255.0",
+		},
+	}
+	response, err := bmcService.SetNetworkConfig(ctx, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.TaskId == "" {
+		t.Fatal("expected taskId, got:", response.TaskId)
+	}
+}
+
+func TestNetworkConfigTimeout(t *testing.T) {
+	testCases := map[string]struct {
+		timeoutMs       int32
+		verifyTimeoutMs int32
+		max             time.Duration
+		want            time.Duration
+		wantErr         bool
+	}{
+		"default":                {want: 4 * time.Minute},
+		"requested":              {timeoutMs: 5000, verifyTimeoutMs: 10000, max: time.Hour, want: 15 * time.Second},
+		"default verify lowered": {timeoutMs: 60000, max: 2 * time.Minute, want: 2 * time.Minute},
+		"above maximum":          {timeoutMs: 60000, verifyTimeoutMs: 3600000, max: 30 * time.Minute, wantErr: true},
+		"verify above maximum":   {verifyTimeoutMs: 3600000, max: time.Hour, wantErr: true},
+		"no maximum":             {timeoutMs: 60000, verifyTimeoutMs: 3600000, want: 61 * time.Minute},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			in := &v1.SetNetworkConfigRequest{TimeoutMs: tc.timeoutMs, VerifyTimeoutMs: tc.verifyTimeoutMs}
+			got, err := networkConfigTimeout(2*time.Minute, tc.max, in)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expected error %v, got: %v", tc.wantErr, err)
 			}
-			if diff := cmp.Diff(tc.expectedErr.Error(), err.Error()); diff != "" {
-				t.Fatal(diff)
+			if got != tc.want {
+				t.Fatalf("expected %v, got: %v", tc.want, got)
 			}
 		})
 	}
 }
 
+func TestSetNetworkConfigVerifyTimeoutAboveMaximum(t *testing.T) {
+	svc := BmcService{TaskRunner: bmcService.TaskRunner, Timeout: time.Minute, MaxTimeout: 10 * time.Minute}
+	in := &v1.SetNetworkConfigRequest{
+		Authn: &v1.Authn{
+			Authn: &v1.Authn_DirectAuthn{
+				DirectAuthn: &v1.DirectAuthn{Host: &v1.Host{Host: "127.0.0.1"}, Username: "ADMIN", Password: "ADMIN"},
+			},
+		},
+		NetworkConfig:   &v1.NetworkConfig{NetworkSource: v1.NetworkSource_NETWORK_SOURCE_DHCP},
+		VerifyTimeoutMs: 3600000,
+	}
+	_, err := svc.SetNetworkConfig(ctx, in)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got: %v", err)
+	}
+}
+
+func TestGetNetworkConfigError(t *testing.T) {
+	svc := BmcService{Timeout: time.Second}
+	_, err := svc.GetNetworkConfig(ctx, &v1.GetNetworkConfigRequest{})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected the BMC error's code, got: %v", err)
+	}
+}
+
 func newResetRequest(authErr bool) *v1.ResetRequest {
 	var auth *v1.DirectAuthn
 	if authErr {
@@ -0,0 +1,108 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// CallbackPolicy restricts the hosts the callback URL of a task request may point at,
+// so that requests can't have the server POST to services only it can reach.
+// The zero value allows any host whose addresses are not loopback or link-local.
+type CallbackPolicy struct {
+	// hosts are the allowed host names, lower case.
+	hosts map[string]bool
+	// networks are the allowed address ranges.
+	networks []*net.IPNet
+	// lookup resolves host names, net.DefaultResolver when nil.
+	lookup func(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// NewCallbackPolicy returns a policy that only allows callback URLs whose host is one of the
+// host names in allowlist, or whose addresses are all in one of its IPs or CIDRs. An empty
+// allowlist returns the zero CallbackPolicy. Allowed addresses may be loopback or link-local.
+func NewCallbackPolicy(allowlist []string) (CallbackPolicy, error) {
+	var p CallbackPolicy
+	for _, entry := range allowlist {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		if ip := net.ParseIP(entry); ip != nil {
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			p.networks = append(p.networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		if strings.Contains(entry, "/") {
+			_, network, err := net.ParseCIDR(entry)
+			if err != nil {
+				return CallbackPolicy{}, fmt.Errorf("invalid callback allowlist entry %q: %w", entry, err)
+			}
+			p.networks = append(p.networks, network)
+			continue
+		}
+		if p.hosts == nil {
+			p.hosts = make(map[string]bool)
+		}
+		p.hosts[entry] = true
+	}
+	return p, nil
+}
+
+// check returns an error unless a task's final status can be POSTed to u under the policy.
+// Host names are resolved, and each of their addresses must be allowed.
+func (p CallbackPolicy) check(ctx context.Context, u string) error {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return fmt.Errorf("invalid callback url: %w", err)
+	}
+	if !strings.EqualFold(parsed.Scheme, "http") && !strings.EqualFold(parsed.Scheme, "https") {
+		return fmt.Errorf("invalid callback url %q: the scheme must be http or https", u)
+	}
+	if parsed.Host == "" {
+		return fmt.Errorf("invalid callback url %q: a host is required", u)
+	}
+	host := strings.ToLower(parsed.Hostname())
+	if p.hosts[host] {
+		return nil
+	}
+	addrs, err := p.resolve(ctx, host)
+	if err != nil {
+		return fmt.Errorf("invalid callback url %q: unable to resolve its host: %w", u, err)
+	}
+	for _, addr := range addrs {
+		if !p.allowed(addr.IP) {
+			return fmt.Errorf("invalid callback url %q: its host address %v is not allowed", u, addr.IP)
+		}
+	}
+	return nil
+}
+
+// resolve returns the addresses of host, which is itself one when it is an IP.
+func (p CallbackPolicy) resolve(ctx context.Context, host string) ([]net.IPAddr, error) {
+	if ip := net.ParseIP(host); ip != nil {
+		return []net.IPAddr{{IP: ip}}, nil
+	}
+	if p.lookup != nil {
+		return p.lookup(ctx, host)
+	}
+	return net.DefaultResolver.LookupIPAddr(ctx, host)
+}
+
+// allowed reports whether a callback may be POSTed to ip.
+func (p CallbackPolicy) allowed(ip net.IP) bool {
+	if len(p.hosts) == 0 && len(p.networks) == 0 {
+		return !ip.IsLoopback() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() && !ip.IsUnspecified()
+	}
+	for _, network := range p.networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
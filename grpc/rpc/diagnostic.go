@@ -4,10 +4,13 @@ import (
 	"context"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/rs/xid"
 	v1 "github.com/tinkerbell/pbnj/api/v1"
 	"github.com/tinkerbell/pbnj/grpc/oob/diagnostic"
+	"github.com/tinkerbell/pbnj/grpc/taskrunner"
 	"github.com/tinkerbell/pbnj/pkg/logging"
+	"github.com/tinkerbell/pbnj/pkg/repository"
 	"github.com/tinkerbell/pbnj/pkg/task"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -17,6 +20,20 @@ type DiagnosticService struct {
 	v1.UnimplementedDiagnosticServer
 	TaskRunner task.Task
 	Timeout    time.Duration
+	// MaxTimeout caps the timeout a request can ask for, zero means no cap.
+	MaxTimeout time.Duration
+	// Callbacks restricts the callback URLs of task requests.
+	Callbacks CallbackPolicy
+}
+
+// ClearSystemEventLogRerun is the rerun kind of clear system event log tasks.
+const ClearSystemEventLogRerun = "clear_system_event_log"
+
+// Reruns returns the functions that rebuild the actions of the service's tasks, keyed by rerun kind.
+func (d *DiagnosticService) Reruns() map[string]taskrunner.RerunFunc {
+	return map[string]taskrunner.RerunFunc{
+		ClearSystemEventLogRerun: rerunFunc(d.clearSystemEventLogAction),
+	}
 }
 
 func (d *DiagnosticService) Screenshot(ctx context.Context, in *v1.ScreenshotRequest) (*v1.ScreenshotResponse, error) {
@@ -59,26 +76,35 @@ func (d *DiagnosticService) ClearSystemEventLog(ctx context.Context, in *v1.Clea
 		"vendor", in.Vendor.GetName(),
 	)
 
-	execFunc := func(s chan string) (string, error) {
+	opts, err := taskOptions(ctx, d.Callbacks, ClearSystemEventLogRerun, in)
+	if err != nil {
+		return nil, err
+	}
+	taskID = d.TaskRunner.Execute(ctx, l, "clearing system event log", taskID, d.clearSystemEventLogAction(ctx, l, in), opts...)
+
+	return &v1.ClearSystemEventLogResponse{TaskId: taskID}, nil
+}
+
+// clearSystemEventLogAction returns the task action that clears the system event log of the request.
+func (d *DiagnosticService) clearSystemEventLogAction(ctx context.Context, l logr.Logger, in *v1.ClearSystemEventLogRequest) func(context.Context, chan repository.StatusMessage) (task.Result, error) {
+	return func(runCtx context.Context, s chan repository.StatusMessage) (task.Result, error) {
 		csl, err := diagnostic.NewSystemEventLogClearer(
 			in,
 			diagnostic.WithLogger(l),
 			diagnostic.WithStatusMessage(s),
 		)
 		if err != nil {
-			return "", err
+			return task.Result{}, err
 		}
 		// Because this is a background task, we want to pass through the span context, but not be
-		// a child context. This allows us to correctly plumb otel into the background task.
-		c := trace.ContextWithSpanContext(context.Background(), trace.SpanContextFromContext(ctx))
-		taskCtx, cancel := context.WithTimeout(c, d.Timeout)
+		// a child context of the request. This allows us to correctly plumb otel into the background task.
+		// runCtx is cancelled by the TaskRunner when the task is cancelled.
+		c := trace.ContextWithSpanContext(runCtx, trace.SpanContextFromContext(ctx))
+		taskCtx, cancel := context.WithTimeout(c, taskTimeout(d.Timeout, d.MaxTimeout, in))
 		defer cancel()
-		return csl.ClearSystemEventLog(taskCtx)
+		result, err := csl.ClearSystemEventLog(taskCtx)
+		return task.Result{Text: result}, err
 	}
-
-	d.TaskRunner.Execute(ctx, l, "clearing system event log", taskID, execFunc)
-
-	return &v1.ClearSystemEventLogResponse{TaskId: taskID}, nil
 }
 
 func (d *DiagnosticService) SendNMI(ctx context.Context, in *v1.SendNMIRequest) (*emptypb.Empty, error) {
@@ -4,10 +4,13 @@ import (
 	"context"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/rs/xid"
 	v1 "github.com/tinkerbell/pbnj/api/v1"
 	"github.com/tinkerbell/pbnj/grpc/oob/machine"
+	"github.com/tinkerbell/pbnj/grpc/taskrunner"
 	"github.com/tinkerbell/pbnj/pkg/logging"
+	"github.com/tinkerbell/pbnj/pkg/repository"
 	"github.com/tinkerbell/pbnj/pkg/task"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -18,11 +21,26 @@ type MachineService struct {
 	// before it is cancelled. This is for use in a
 	// TaskRunner.Execute function that runs all BMC
 	// interactions in the background.
-	Timeout    time.Duration
+	Timeout time.Duration
+	// MaxTimeout caps the timeout a request can ask for, zero means no cap.
+	MaxTimeout time.Duration
 	TaskRunner task.Task
+	// RerunPowerStatus stores power status requests with their task, so that
+	// they can be run again after a restart. The task runner
+	// only stores them encrypted, as they hold the BMC credentials.
+	RerunPowerStatus bool
+	// Callbacks restricts the callback URLs of task requests.
+	Callbacks CallbackPolicy
 	v1.UnimplementedMachineServer
 }
 
+// Rerun kinds of the service's tasks.
+const (
+	BootDeviceRerun = "boot_device"
+	PowerRerun      = "power"
+	WorkflowRerun   = "workflow"
+)
+
 // BootDevice sets the next boot device of a machine.
 func (m *MachineService) BootDevice(ctx context.Context, in *v1.DeviceRequest) (*v1.DeviceResponse, error) {
 	l := logging.ExtractLogr(ctx)
@@ -38,25 +56,34 @@ func (m *MachineService) BootDevice(ctx context.Context, in *v1.DeviceRequest) (
 		"efiBoot", in.EfiBoot,
 	)
 
-	execFunc := func(s chan string) (string, error) {
+	opts, err := taskOptions(ctx, m.Callbacks, BootDeviceRerun, in)
+	if err != nil {
+		return nil, err
+	}
+	taskID = m.TaskRunner.Execute(ctx, l, "setting boot device", taskID, m.bootDeviceAction(ctx, l, in), opts...)
+
+	return &v1.DeviceResponse{TaskId: taskID}, nil
+}
+
+// bootDeviceAction returns the task action that sets the boot device of the request.
+func (m *MachineService) bootDeviceAction(ctx context.Context, l logr.Logger, in *v1.DeviceRequest) func(context.Context, chan repository.StatusMessage) (task.Result, error) {
+	return func(runCtx context.Context, s chan repository.StatusMessage) (task.Result, error) {
 		mbd, err := machine.NewBootDeviceSetter(
 			machine.WithDeviceRequest(in),
 			machine.WithLogger(l),
 			machine.WithStatusMessage(s),
 		)
 		if err != nil {
-			return "", err
+			return task.Result{}, err
 		}
 		// Because this is a background task, we want to pass through the span context, but not be
-		// a child context. This allows us to correctly plumb otel into the background task.
-		c := trace.ContextWithSpanContext(context.Background(), trace.SpanContextFromContext(ctx))
-		taskCtx, cancel := context.WithTimeout(c, m.Timeout)
+		// a child context of the request. This allows us to correctly plumb otel into the background task.
+		// runCtx is cancelled by the TaskRunner when the task is cancelled.
+		c := trace.ContextWithSpanContext(runCtx, trace.SpanContextFromContext(ctx))
+		taskCtx, cancel := context.WithTimeout(c, taskTimeout(m.Timeout, m.MaxTimeout, in))
 		defer cancel()
 		return mbd.BootDeviceSet(taskCtx, in.BootDevice.String(), in.Persistent, in.EfiBoot)
 	}
-	m.TaskRunner.Execute(ctx, l, "setting boot device", taskID, execFunc)
-
-	return &v1.DeviceResponse{TaskId: taskID}, nil
 }
 
 // Power does a power action against a BMC.
@@ -73,23 +100,97 @@ func (m *MachineService) Power(ctx context.Context, in *v1.PowerRequest) (*v1.Po
 		"OffDuration", in.OffDuration,
 	)
 
-	execFunc := func(s chan string) (string, error) {
+	opts, err := taskOptions(ctx, m.Callbacks, PowerRerun, in)
+	if err != nil {
+		return nil, err
+	}
+	if m.RerunPowerStatus && in.GetPowerAction() == v1.PowerAction_POWER_ACTION_STATUS {
+		if request, err := storedRequest(in); err == nil {
+			opts = append(opts, task.WithRerun(PowerRerun, request))
+		}
+	}
+	taskID = m.TaskRunner.Execute(ctx, l, "power action: "+in.GetPowerAction().String(), taskID, m.powerAction(ctx, l, in), opts...)
+
+	return &v1.PowerResponse{TaskId: taskID}, nil
+}
+
+// Workflow runs the steps of a request against a machine as a single task.
+func (m *MachineService) Workflow(ctx context.Context, in *v1.WorkflowRequest) (*v1.WorkflowResponse, error) {
+	l := logging.ExtractLogr(ctx)
+	taskID := xid.New().String()
+	l = l.WithValues("taskID", taskID, "bmcIP", in.Authn.GetDirectAuthn().GetHost().GetHost())
+	description := machine.WorkflowDescription(in)
+	l.Info(
+		"start Workflow request",
+		"username", in.Authn.GetDirectAuthn().GetUsername(),
+		"vendor", in.Vendor.GetName(),
+		"steps", description,
+	)
+
+	// the workflow is shared by the attempts of the task, so that a retry resumes at the step that failed.
+	wf, err := machine.NewWorkflow(in, machine.WithLogger(l))
+	if err != nil {
+		return nil, err
+	}
+	opts, err := taskOptions(ctx, m.Callbacks, WorkflowRerun, in)
+	if err != nil {
+		return nil, err
+	}
+	taskID = m.TaskRunner.Execute(ctx, l, description, taskID, m.workflowAction(ctx, in, wf), opts...)
+
+	return &v1.WorkflowResponse{TaskId: taskID}, nil
+}
+
+// workflowAction returns the task action that runs the workflow of the request.
+// The request's timeout applies to each step of the workflow.
+func (m *MachineService) workflowAction(ctx context.Context, in *v1.WorkflowRequest, wf *machine.Workflow) func(context.Context, chan repository.StatusMessage) (task.Result, error) {
+	wf.StepTimeout = taskTimeout(m.Timeout, m.MaxTimeout, in)
+	return func(runCtx context.Context, s chan repository.StatusMessage) (task.Result, error) {
+		// Because this is a background task, we want to pass through the span context, but not be
+		// a child context of the request. This allows us to correctly plumb otel into the background task.
+		// runCtx is cancelled by the TaskRunner when the task is cancelled.
+		return wf.Run(trace.ContextWithSpanContext(runCtx, trace.SpanContextFromContext(ctx)), s)
+	}
+}
+
+// rerunWorkflowAction returns the action of a workflow task rebuilt from its stored request.
+// The workflow is built once, so that a retry resumes at the step that failed.
+func (m *MachineService) rerunWorkflowAction(ctx context.Context, l logr.Logger, in *v1.WorkflowRequest) func(context.Context, chan repository.StatusMessage) (task.Result, error) {
+	wf, err := machine.NewWorkflow(in, machine.WithLogger(l))
+	if err != nil {
+		return func(context.Context, chan repository.StatusMessage) (task.Result, error) {
+			return task.Result{}, err
+		}
+	}
+	return m.workflowAction(ctx, in, wf)
+}
+
+// Reruns returns the functions that rebuild the actions of the service's tasks, keyed by rerun kind.
+func (m *MachineService) Reruns() map[string]taskrunner.RerunFunc {
+	return map[string]taskrunner.RerunFunc{
+		BootDeviceRerun: rerunFunc(m.bootDeviceAction),
+		PowerRerun:      rerunFunc(m.powerAction),
+		WorkflowRerun:   rerunFunc(m.rerunWorkflowAction),
+	}
+}
+
+// powerAction returns the task action that does the power request.
+func (m *MachineService) powerAction(ctx context.Context, l logr.Logger, in *v1.PowerRequest) func(context.Context, chan repository.StatusMessage) (task.Result, error) {
+	return func(runCtx context.Context, s chan repository.StatusMessage) (task.Result, error) {
 		mp, err := machine.NewPowerSetter(
 			machine.WithPowerRequest(in),
 			machine.WithLogger(l),
 			machine.WithStatusMessage(s),
 		)
 		if err != nil {
-			return "", err
+			return task.Result{}, err
 		}
 		// Because this is a background task, we want to pass through the span context, but not be
-		// a child context. This allows us to correctly plumb otel into the background task.
-		c := trace.ContextWithSpanContext(context.Background(), trace.SpanContextFromContext(ctx))
-		taskCtx, cancel := context.WithTimeout(c, m.Timeout)
+		// a child context of the request. This allows us to correctly plumb otel into the background task.
+		// runCtx is cancelled by the TaskRunner when the task is cancelled.
+		c := trace.ContextWithSpanContext(runCtx, trace.SpanContextFromContext(ctx))
+		taskCtx, cancel := context.WithTimeout(c, taskTimeout(m.Timeout, m.MaxTimeout, in))
 		defer cancel()
 		return mp.PowerSet(taskCtx, in.PowerAction.String())
 	}
-	m.TaskRunner.Execute(ctx, l, "power action: "+in.GetPowerAction().String(), taskID, execFunc)
-
-	return &v1.PowerResponse{TaskId: taskID}, nil
 }
@@ -3,8 +3,11 @@ package rpc
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
 	"github.com/onsi/gomega"
 	"github.com/philippgille/gokv"
@@ -12,6 +15,9 @@ import (
 	v1 "github.com/tinkerbell/pbnj/api/v1"
 	"github.com/tinkerbell/pbnj/grpc/persistence"
 	"github.com/tinkerbell/pbnj/grpc/taskrunner"
+	"github.com/tinkerbell/pbnj/pkg/repository"
+	"github.com/tinkerbell/pbnj/pkg/task"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestDevice(t *testing.T) {
@@ -142,6 +148,24 @@ func TestPower(t *testing.T) {
 			},
 			message: "on",
 		},
+		{
+			name: "invalid callback url",
+			req: &v1.PowerRequest{
+				Authn: &v1.Authn{
+					Authn: &v1.Authn_DirectAuthn{
+						DirectAuthn: &v1.DirectAuthn{
+							Host: &v1.Host{
+								Host: "10.1.1.1",
+							},
+							Username: "admin",
+							Password: "admin",
+						},
+					},
+				},
+				CallbackUrl: "ftp://hooks.example.com/tasks",
+			},
+			expectedErr: errors.New(`rpc error: code = InvalidArgument desc = invalid callback url "ftp://hooks.example.com/tasks": the scheme must be http or https`),
+		},
 		{
 			name:        "validation failure",
 			req:         &v1.PowerRequest{Authn: &v1.Authn{Authn: &v1.Authn_DirectAuthn{DirectAuthn: &v1.DirectAuthn{}}}},
@@ -187,3 +211,192 @@ func TestPower(t *testing.T) {
 		})
 	}
 }
+
+func TestPowerIdempotencyKey(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	repo := &persistence.GoKV{
+		Store: s,
+		Ctx:   ctx,
+	}
+	taskRunner := &taskrunner.Runner{
+		Repository: repo,
+		Ctx:        ctx,
+	}
+	machineSvc := MachineService{
+		TaskRunner: taskRunner,
+	}
+	req := &v1.PowerRequest{
+		Authn: &v1.Authn{
+			Authn: &v1.Authn_DirectAuthn{
+				DirectAuthn: &v1.DirectAuthn{
+					Host:     &v1.Host{Host: "10.1.1.1"},
+					Username: "admin",
+					Password: "admin",
+				},
+			},
+		},
+		PowerAction: v1.PowerAction_POWER_ACTION_CYCLE,
+	}
+
+	keyCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(task.IdempotencyKeyHeader, "abc"))
+	first, err := machineSvc.Power(keyCtx, req)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	retry, err := machineSvc.Power(keyCtx, req)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(retry.TaskId).To(gomega.Equal(first.TaskId))
+
+	other, err := machineSvc.Power(ctx, req)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(other.TaskId).ToNot(gomega.Equal(first.TaskId))
+}
+
+// createdRecords records the task records created in the repository it wraps.
+type createdRecords struct {
+	repository.Actions
+	mu      sync.Mutex
+	records []repository.Record
+}
+
+// Create records the task record and creates it in the wrapped repository.
+func (c *createdRecords) Create(id string, val repository.Record) error {
+	c.mu.Lock()
+	c.records = append(c.records, val)
+	c.mu.Unlock()
+	return c.Actions.Create(id, val)
+}
+
+func TestPowerStatusRerunSealed(t *testing.T) {
+	tests := map[string]struct {
+		key    []byte
+		stored bool
+	}{
+		"stored encrypted":         {key: []byte("0123456789abcdef0123456789abcdef"), stored: true},
+		"not stored without a key": {},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			ctx := context.Background()
+			f := freecache.NewStore(freecache.DefaultOptions)
+			s := gokv.Store(f)
+			repo := &createdRecords{Actions: &persistence.GoKV{Store: s, Ctx: ctx}}
+			machineSvc := MachineService{
+				TaskRunner:       &taskrunner.Runner{Repository: repo, Ctx: ctx, RequestKey: tc.key},
+				Timeout:          time.Second,
+				RerunPowerStatus: true,
+			}
+			req := &v1.PowerRequest{
+				Authn: &v1.Authn{
+					Authn: &v1.Authn_DirectAuthn{
+						DirectAuthn: &v1.DirectAuthn{
+							Host:     &v1.Host{Host: "10.1.1.1"},
+							Username: "admin",
+							Password: "secret-password",
+						},
+					},
+				},
+				PowerAction: v1.PowerAction_POWER_ACTION_STATUS,
+			}
+			_, err := machineSvc.Power(ctx, req)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+
+			g.Eventually(func() int {
+				repo.mu.Lock()
+				defer repo.mu.Unlock()
+				return len(repo.records)
+			}).Should(gomega.Equal(1))
+			repo.mu.Lock()
+			rerun := repo.records[0].Rerun
+			repo.mu.Unlock()
+			if !tc.stored {
+				g.Expect(rerun).To(gomega.BeNil())
+				return
+			}
+			g.Expect(rerun).ToNot(gomega.BeNil())
+			g.Expect(rerun.Kind).To(gomega.Equal(PowerRerun))
+			g.Expect(rerun.Request).To(gomega.BeNil())
+			g.Expect(string(rerun.Sealed)).ToNot(gomega.ContainSubstring("secret-password"))
+		})
+	}
+}
+
+func TestWorkflow(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	repo := &persistence.GoKV{
+		Store: s,
+		Ctx:   ctx,
+	}
+	taskRunner := &taskrunner.Runner{
+		Repository: repo,
+		Ctx:        ctx,
+	}
+	machineSvc := MachineService{
+		TaskRunner: taskRunner,
+		Timeout:    time.Minute,
+	}
+	req := &v1.WorkflowRequest{
+		Authn: &v1.Authn{
+			Authn: &v1.Authn_DirectAuthn{
+				DirectAuthn: &v1.DirectAuthn{
+					Host:     &v1.Host{Host: "10.1.1.1"},
+					Username: "admin",
+					Password: "admin",
+				},
+			},
+		},
+		Steps: []*v1.WorkflowStep{
+			{Step: &v1.WorkflowStep_Wait{Wait: &v1.WaitStep{DurationMs: 1}}},
+			{Step: &v1.WorkflowStep_Wait{Wait: &v1.WaitStep{DurationMs: 1}}},
+		},
+	}
+
+	response, err := machineSvc.Workflow(ctx, req)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(response.TaskId).Should(gomega.HaveLen(20))
+
+	g.Eventually(func() bool {
+		record, err := taskRunner.Status(ctx, response.TaskId)
+		return err == nil && record.Complete
+	}, "5s", "10ms").Should(gomega.BeTrue())
+	record, err := taskRunner.Status(ctx, response.TaskId)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(record.Failed()).To(gomega.BeFalse())
+	g.Expect(record.Description).To(gomega.Equal("workflow: wait 1ms, wait 1ms"))
+	g.Expect(record.Messages).To(gomega.ContainElements("starting step 1 of 2 (wait 1ms)", "step 2 of 2 (wait 1ms) complete"))
+}
+
+func TestMachineReruns(t *testing.T) {
+	machineSvc := MachineService{Timeout: time.Minute}
+	tests := map[string]struct {
+		kind    string
+		request string
+		wantErr bool
+	}{
+		"boot device":       {kind: BootDeviceRerun, request: `{"bootDevice":"BOOT_DEVICE_PXE"}`},
+		"power":             {kind: PowerRerun, request: `{"powerAction":"POWER_ACTION_ON"}`},
+		"workflow":          {kind: WorkflowRerun, request: `{"steps":[{"wait":{"durationMs":1}}]}`},
+		"malformed request": {kind: WorkflowRerun, request: `{"steps":`, wantErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rerun, ok := machineSvc.Reruns()[tc.kind]
+			if !ok {
+				t.Fatalf("no rerun for kind %q", tc.kind)
+			}
+			action, err := rerun(logr.Discard(), []byte(tc.request))
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expected error: %v, got: %v", tc.wantErr, err)
+			}
+			if !tc.wantErr && action == nil {
+				t.Fatal("expected an action")
+			}
+		})
+	}
+}
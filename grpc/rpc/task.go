@@ -2,12 +2,32 @@ package rpc
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/go-logr/logr"
 	v1 "github.com/tinkerbell/pbnj/api/v1"
+	"github.com/tinkerbell/pbnj/grpc/taskrunner"
 	"github.com/tinkerbell/pbnj/pkg/logging"
+	"github.com/tinkerbell/pbnj/pkg/repository"
 	"github.com/tinkerbell/pbnj/pkg/task"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// defaultListPageSize is the number of tasks returned by List when no page size is requested.
+	defaultListPageSize = 100
+	// maxListPageSize caps the number of tasks returned by a single List call.
+	maxListPageSize = 1000
 )
 
 // TaskService for retrieving task details.
@@ -26,22 +46,288 @@ func (t *TaskService) Status(ctx context.Context, in *v1.StatusRequest) (*v1.Sta
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
 
-	c := codes.OK
-	if record.Error.Message != "" {
-		if codes.Code(record.Error.Code) != codes.OK {
-			c = codes.Code(record.Error.Code)
-		} else {
+	return task.StatusResponse(record), recordError(record)
+}
+
+// Watch streams the status of a task each time it changes.
+// The stream ends with the final status, and the task's error if it failed.
+func (t *TaskService) Watch(in *v1.WatchRequest, stream v1.Task_WatchServer) error {
+	ctx := stream.Context()
+	l := logging.ExtractLogr(ctx)
+	l.Info("start Watch request", "taskID", in.TaskId)
+
+	records, err := t.TaskRunner.Watch(ctx, in.TaskId)
+	if err != nil {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	var last repository.Record
+	for record := range records {
+		if err := stream.Send(task.StatusResponse(record)); err != nil {
+			return err
+		}
+		last = record
+	}
+	if err := ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+	if !last.Complete {
+		return status.Error(codes.Unavailable, "task status is no longer available")
+	}
+	return recordError(last)
+}
+
+// List returns a page of task records matching the request filters, newest first.
+func (t *TaskService) List(ctx context.Context, in *v1.ListRequest) (*v1.ListResponse, error) {
+	l := logging.ExtractLogr(ctx)
+	l.Info("start List request", "host", in.Host, "states", in.States)
+
+	cursor, err := decodePageToken(in.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	size := int(in.PageSize)
+	if size == 0 {
+		size = defaultListPageSize
+	}
+	if size > maxListPageSize {
+		size = maxListPageSize
+	}
+
+	records, err := t.TaskRunner.List(ctx, listFilter(in))
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	resp := &v1.ListResponse{}
+	start := 0
+	if cursor != nil {
+		// records are newest first, so the page starts at the first record older than the cursor.
+		start = sort.Search(len(records), func(i int) bool { return cursor.before(records[i]) })
+	}
+	end := start + size
+	if end < len(records) {
+		resp.NextPageToken = encodePageToken(records[end-1])
+	} else {
+		end = len(records)
+	}
+	for _, record := range records[start:end] {
+		resp.Tasks = append(resp.Tasks, task.StatusResponse(record))
+	}
+	return resp, nil
+}
+
+// cancelCode returns the gRPC code of an error cancelling a task.
+func cancelCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		return codes.NotFound
+	case errors.Is(err, task.ErrNotRunning), errors.Is(err, repository.ErrConflict):
+		return codes.FailedPrecondition
+	case errors.Is(err, task.ErrRunningElsewhere):
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
+
+func listFilter(in *v1.ListRequest) repository.Filter {
+	f := repository.Filter{
+		States:      in.States,
+		Host:        in.Host,
+		Description: in.Description,
+	}
+	switch in.Completion {
+	case v1.Completion_COMPLETION_COMPLETE:
+		complete := true
+		f.Complete = &complete
+	case v1.Completion_COMPLETION_INCOMPLETE:
+		complete := false
+		f.Complete = &complete
+	case v1.Completion_COMPLETION_UNSPECIFIED:
+	}
+	if in.CreatedAfter != nil {
+		f.CreatedAfter = in.CreatedAfter.AsTime()
+	}
+	if in.CreatedBefore != nil {
+		f.CreatedBefore = in.CreatedBefore.AsTime()
+	}
+	return f
+}
+
+// pageCursor is the last record of a page, in the newest first order of List.
+// Paging from it rather than an offset doesn't skip or repeat records when
+// tasks are created or reaped between pages.
+type pageCursor struct {
+	createdAt time.Time
+	id        string
+}
+
+// before reports whether the record comes after the cursor, i.e. is older or created
+// at the same time with a lower ID.
+func (c pageCursor) before(r repository.Record) bool {
+	if !r.CreatedAt.Equal(c.createdAt) {
+		return r.CreatedAt.Before(c.createdAt)
+	}
+	return r.ID < c.id
+}
+
+// Page tokens are opaque to clients; they hold the creation time and ID of the
+// last record of the page.
+func encodePageToken(last repository.Record) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(last.CreatedAt.UTC().Format(time.RFC3339Nano) + " " + last.ID))
+}
+
+func decodePageToken(token string) (*pageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.New("invalid page token")
+	}
+	created, id, ok := strings.Cut(string(b), " ")
+	if !ok || id == "" {
+		return nil, errors.New("invalid page token")
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, created)
+	if err != nil {
+		return nil, errors.New("invalid page token")
+	}
+	return &pageCursor{createdAt: createdAt, id: id}, nil
+}
+
+// bmcError returns the gRPC status error of an error from a BMC call made during a request,
+// keeping the code of a *repository.Error.
+func bmcError(err error) error {
+	var re *repository.Error
+	if errors.As(err, &re) {
+		c := codes.Code(re.Code)
+		if c == codes.OK {
 			c = codes.Unknown
 		}
+		return status.Error(c, re.Message)
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return status.FromContextError(err).Err()
 	}
+	return status.Error(codes.Unknown, err.Error())
+}
 
-	return &v1.StatusResponse{
-		Id:          record.ID,
-		Description: record.Description,
-		Error:       nil,
-		State:       record.State,
-		Result:      record.Result,
-		Complete:    record.Complete,
-		Messages:    record.Messages,
-	}, status.Error(c, record.Error.Message)
+// recordError returns the gRPC status error of a failed task, or nil.
+func recordError(record repository.Record) error {
+	if record.Error == nil || record.Error.Message == "" {
+		return nil
+	}
+	c := codes.Unknown
+	if codes.Code(record.Error.Code) != codes.OK {
+		c = codes.Code(record.Error.Code)
+	}
+	return status.Error(c, record.Error.Message)
+}
+
+// Cancel stops a running task. A task run by another server is stopped once that
+// server sees the request.
+func (t *TaskService) Cancel(ctx context.Context, in *v1.CancelRequest) (*v1.CancelResponse, error) {
+	l := logging.ExtractLogr(ctx)
+	l.Info("start Cancel request", "taskID", in.TaskId)
+
+	if err := t.TaskRunner.Cancel(ctx, in.TaskId); err != nil {
+		return nil, status.Error(cancelCode(err), err.Error())
+	}
+
+	return &v1.CancelResponse{TaskId: in.TaskId}, nil
+}
+
+// taskRequest is implemented by requests that start a task.
+type taskRequest interface {
+	proto.Message
+	GetAuthn() *v1.Authn
+	GetRetryPolicy() *v1.RetryPolicy
+	GetCallbackUrl() string
+	GetTimeoutMs() int32
+	GetNotBefore() *timestamppb.Timestamp
+}
+
+// taskOptions returns the task.Options requested by the fields common to all task requests
+// and by the request metadata. The request of a task scheduled to start later is stored
+// with it under the rerun kind, so that the task still starts after a restart if the
+// task runner has a key to encrypt it with. A callback URL that is invalid or not allowed by
+// callbacks is an InvalidArgument error.
+func taskOptions(ctx context.Context, callbacks CallbackPolicy, rerunKind string, in taskRequest) ([]task.Option, error) {
+	var opts []task.Option
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if keys := md.Get(task.IdempotencyKeyHeader); len(keys) > 0 && keys[0] != "" {
+			opts = append(opts, task.WithIdempotencyKey(keys[0]))
+		}
+	}
+	if host := in.GetAuthn().GetDirectAuthn().GetHost().GetHost(); host != "" {
+		opts = append(opts, task.WithHost(host))
+	}
+	if p := in.GetRetryPolicy(); p != nil {
+		opts = append(opts, task.WithRetryPolicy(retryPolicy(p)))
+	}
+	if u := in.GetCallbackUrl(); u != "" {
+		if err := callbacks.check(ctx, u); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		opts = append(opts, task.WithCallbackURL(u))
+	}
+	if nb := in.GetNotBefore(); nb.IsValid() {
+		opts = append(opts, task.WithNotBefore(nb.AsTime()))
+		if nb.AsTime().After(time.Now()) {
+			if request, err := storedRequest(in); err == nil {
+				opts = append(opts, task.WithScheduledRerun(rerunKind, request))
+			}
+		}
+	}
+	return opts, nil
+}
+
+// storedRequest encodes a request to be stored with its task for rerunFunc to decode.
+// It includes the request's credentials, so the task runner only stores it encrypted.
+func storedRequest(in proto.Message) ([]byte, error) {
+	return protojson.Marshal(in)
+}
+
+// rerunFunc returns the taskrunner.RerunFunc that rebuilds a task's action with action
+// from its request stored by taskOptions.
+func rerunFunc[T taskRequest](action func(context.Context, logr.Logger, T) func(context.Context, chan repository.StatusMessage) (task.Result, error)) taskrunner.RerunFunc {
+	return func(l logr.Logger, request []byte) (func(context.Context, chan repository.StatusMessage) (task.Result, error), error) {
+		var zero T
+		in, ok := zero.ProtoReflect().New().Interface().(T)
+		if !ok {
+			return nil, fmt.Errorf("unexpected request type %T", in)
+		}
+		if err := protojson.Unmarshal(request, in); err != nil {
+			return nil, err
+		}
+		return action(context.Background(), l, in), nil
+	}
+}
+
+// taskTimeout returns how long the task of a request may run: the timeout the request
+// asks for, lowered to maxTimeout when that is set, or def when it asks for none.
+func taskTimeout(def, maxTimeout time.Duration, in taskRequest) time.Duration {
+	t := time.Duration(in.GetTimeoutMs()) * time.Millisecond
+	if t <= 0 {
+		return def
+	}
+	if maxTimeout > 0 && t > maxTimeout {
+		return maxTimeout
+	}
+	return t
+}
+
+func retryPolicy(p *v1.RetryPolicy) task.RetryPolicy {
+	retryable := make([]int32, 0, len(p.GetRetryableCodes()))
+	for _, c := range p.GetRetryableCodes() {
+		retryable = append(retryable, int32(c))
+	}
+	return task.RetryPolicy{
+		MaxAttempts:    int(p.GetMaxAttempts()),
+		InitialBackoff: time.Duration(p.GetInitialBackoffMs()) * time.Millisecond,
+		MaxBackoff:     time.Duration(p.GetMaxBackoffMs()) * time.Millisecond,
+		Multiplier:     p.GetBackoffMultiplier(),
+		RetryableCodes: retryable,
+	}
 }
@@ -2,6 +2,9 @@ package rpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net"
 	"testing"
 	"time"
 
@@ -14,6 +17,12 @@ import (
 	"github.com/tinkerbell/pbnj/grpc/persistence"
 	"github.com/tinkerbell/pbnj/grpc/taskrunner"
 	"github.com/tinkerbell/pbnj/pkg/repository"
+	"github.com/tinkerbell/pbnj/pkg/task"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestTaskFound(t *testing.T) {
@@ -34,8 +43,8 @@ func TestTaskFound(t *testing.T) {
 		Ctx:        ctx,
 	}
 	taskID := xid.New().String()
-	taskRunner.Execute(ctx, logger, "test", taskID, func(_ chan string) (string, error) {
-		return "doing cool stuff", defaultError
+	taskRunner.Execute(ctx, logger, "test", taskID, func(_ context.Context, _ chan repository.StatusMessage) (task.Result, error) {
+		return task.Result{Text: "doing cool stuff"}, defaultError
 	})
 
 	taskReq := &v1.StatusRequest{TaskId: taskID}
@@ -103,3 +112,376 @@ func TestRecordNotFound(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskCancel(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	repo := &persistence.GoKV{Store: s, Ctx: ctx}
+	taskRunner := &taskrunner.Runner{
+		Repository: repo,
+		Ctx:        ctx,
+	}
+	taskSvc := TaskService{
+		TaskRunner: taskRunner,
+	}
+
+	taskID := xid.New().String()
+	taskRunner.Execute(ctx, logr.Discard(), "test", taskID, func(ctx context.Context, _ chan repository.StatusMessage) (task.Result, error) {
+		<-ctx.Done()
+		return task.Result{}, ctx.Err()
+	})
+
+	resp, err := taskSvc.Cancel(ctx, &v1.CancelRequest{TaskId: taskID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.TaskId != taskID {
+		t.Fatalf("got: %+v", resp)
+	}
+
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() string {
+		statusResp, _ := taskSvc.Status(ctx, &v1.StatusRequest{TaskId: taskID})
+		return statusResp.GetState()
+	}).Should(gomega.Equal("cancelled"))
+	_, err = taskSvc.Status(ctx, &v1.StatusRequest{TaskId: taskID})
+	g.Expect(status.Code(err)).To(gomega.Equal(codes.Canceled))
+
+	_, err = taskSvc.Cancel(ctx, &v1.CancelRequest{TaskId: taskID})
+	g.Expect(status.Code(err)).To(gomega.Equal(codes.FailedPrecondition))
+
+	_, err = taskSvc.Cancel(ctx, &v1.CancelRequest{TaskId: "123"})
+	g.Expect(status.Code(err)).To(gomega.Equal(codes.NotFound))
+
+	// a task run by another server without leases can't be cancelled from here.
+	g.Expect(repo.Create("elsewhere", repository.Record{ID: "elsewhere", State: "running", Owner: "pbnj-1"})).To(gomega.Succeed())
+	_, err = taskSvc.Cancel(ctx, &v1.CancelRequest{TaskId: "elsewhere"})
+	g.Expect(status.Code(err)).To(gomega.Equal(codes.Unavailable))
+	g.Expect(err.Error()).To(gomega.ContainSubstring("pbnj-1"))
+}
+
+func TestCancelCode(t *testing.T) {
+	testCases := map[string]struct {
+		err  error
+		want codes.Code
+	}{
+		"not found":         {err: fmt.Errorf("%w: 123", repository.ErrNotFound), want: codes.NotFound},
+		"not running":       {err: task.ErrNotRunning, want: codes.FailedPrecondition},
+		"conflict":          {err: repository.ErrConflict, want: codes.FailedPrecondition},
+		"running elsewhere": {err: fmt.Errorf("%w: it is owned by pbnj-1", task.ErrRunningElsewhere), want: codes.Unavailable},
+		"store error":       {err: errors.New("persistence error: connection refused"), want: codes.Internal},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := cancelCode(tc.err); got != tc.want {
+				t.Fatalf("expected %v, got: %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTaskList(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	repo := &persistence.GoKV{Store: s, Ctx: ctx}
+	taskRunner := &taskrunner.Runner{
+		Repository: repo,
+		Ctx:        ctx,
+	}
+	taskSvc := TaskService{
+		TaskRunner: taskRunner,
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	start := func(host string) {
+		taskRunner.Execute(ctx, logr.Discard(), "power action: on", xid.New().String(), func(_ context.Context, _ chan repository.StatusMessage) (task.Result, error) {
+			<-done
+			return task.Result{Text: "on"}, nil
+		}, task.WithHost(host))
+	}
+	hosts := []string{"10.1.1.1", "10.1.1.1:623", "10.1.1.1", "10.2.2.2"}
+	for _, host := range hosts {
+		start(host)
+	}
+
+	g := gomega.NewGomegaWithT(t)
+	g.Eventually(func() int {
+		resp, _ := taskSvc.List(ctx, &v1.ListRequest{States: []string{"running"}})
+		return len(resp.GetTasks())
+	}).Should(gomega.Equal(len(hosts)))
+
+	req := &v1.ListRequest{Host: "10.1.1.1", States: []string{"running"}, PageSize: 2}
+	first, err := taskSvc.List(ctx, req)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(first.NextPageToken).ToNot(gomega.BeEmpty())
+	for _, tsk := range first.Tasks {
+		g.Expect(tsk.Host).To(gomega.BeElementOf("10.1.1.1", "10.1.1.1:623"))
+	}
+
+	// a task created between pages doesn't shift the next page.
+	time.Sleep(time.Millisecond)
+	start("10.1.1.1")
+	g.Eventually(func() int {
+		resp, _ := taskSvc.List(ctx, &v1.ListRequest{States: []string{"running"}})
+		return len(resp.GetTasks())
+	}).Should(gomega.Equal(len(hosts) + 1))
+	req.PageToken = first.NextPageToken
+	second, err := taskSvc.List(ctx, req)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(second.Tasks).To(gomega.HaveLen(1))
+	g.Expect(second.NextPageToken).To(gomega.BeEmpty())
+	g.Expect(second.Tasks[0].Id).ToNot(gomega.BeElementOf(first.Tasks[0].Id, first.Tasks[1].Id))
+
+	resp, err := taskSvc.List(ctx, &v1.ListRequest{Completion: v1.Completion_COMPLETION_COMPLETE})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(resp.Tasks).To(gomega.BeEmpty())
+
+	_, err = taskSvc.List(ctx, &v1.ListRequest{PageToken: "not a token"})
+	g.Expect(status.Code(err)).To(gomega.Equal(codes.InvalidArgument))
+}
+
+type watchStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*v1.StatusResponse
+}
+
+func (w *watchStream) Context() context.Context { return w.ctx }
+
+func (w *watchStream) Send(resp *v1.StatusResponse) error {
+	w.responses = append(w.responses, resp)
+	return nil
+}
+
+func TestTaskWatch(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	repo := &persistence.GoKV{Store: s, Ctx: ctx}
+	taskRunner := &taskrunner.Runner{
+		Repository: repo,
+		Ctx:        ctx,
+	}
+	taskSvc := TaskService{
+		TaskRunner: taskRunner,
+	}
+	g := gomega.NewGomegaWithT(t)
+
+	taskID := xid.New().String()
+	taskRunner.Execute(ctx, logr.Discard(), "test", taskID, func(_ context.Context, msgs chan repository.StatusMessage) (task.Result, error) {
+		msgs <- repository.NewStatusMessage(repository.LevelInfo, "ipmitool", "working")
+		return task.Result{Text: "done"}, nil
+	})
+	stream := &watchStream{ctx: ctx}
+	g.Expect(taskSvc.Watch(&v1.WatchRequest{TaskId: taskID}, stream)).To(gomega.Succeed())
+	g.Expect(stream.responses).ToNot(gomega.BeEmpty())
+	last := stream.responses[len(stream.responses)-1]
+	g.Expect(last.Complete).To(gomega.BeTrue())
+	g.Expect(last.Result).To(gomega.Equal("done"))
+	g.Expect(last.Messages).To(gomega.Equal([]string{"working"}))
+	g.Expect(last.StatusMessages).To(gomega.HaveLen(1))
+	g.Expect(last.StatusMessages[0].Level).To(gomega.Equal(v1.StatusMessage_LEVEL_INFO))
+	g.Expect(last.StatusMessages[0].Provider).To(gomega.Equal("ipmitool"))
+	g.Expect(last.StatusMessages[0].Text).To(gomega.Equal("working"))
+	g.Expect(last.StatusMessages[0].Time).ToNot(gomega.BeNil())
+	g.Expect(last.Attempts).To(gomega.Equal(int32(1)))
+	g.Expect(last.CreatedAt).ToNot(gomega.BeNil())
+	g.Expect(last.StartedAt).ToNot(gomega.BeNil())
+	g.Expect(last.FinishedAt.AsTime()).To(gomega.BeTemporally(">=", last.StartedAt.AsTime()))
+	g.Expect(last.Duration.AsDuration()).To(gomega.Equal(last.FinishedAt.AsTime().Sub(last.StartedAt.AsTime())))
+
+	failedID := xid.New().String()
+	taskRunner.Execute(ctx, logr.Discard(), "test", failedID, func(_ context.Context, _ chan repository.StatusMessage) (task.Result, error) {
+		return task.Result{}, &repository.Error{Code: v1.Code_value["UNAVAILABLE"], Message: "bmc unreachable"}
+	})
+	stream = &watchStream{ctx: ctx}
+	err := taskSvc.Watch(&v1.WatchRequest{TaskId: failedID}, stream)
+	g.Expect(status.Code(err)).To(gomega.Equal(codes.Unavailable))
+	g.Expect(stream.responses[len(stream.responses)-1].Complete).To(gomega.BeTrue())
+
+	err = taskSvc.Watch(&v1.WatchRequest{TaskId: "123"}, &watchStream{ctx: ctx})
+	g.Expect(status.Code(err)).To(gomega.Equal(codes.NotFound))
+}
+
+func TestTaskTypedResult(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	repo := &persistence.GoKV{Store: s, Ctx: ctx}
+	taskRunner := &taskrunner.Runner{
+		Repository: repo,
+		Ctx:        ctx,
+	}
+	taskSvc := TaskService{
+		TaskRunner: taskRunner,
+	}
+	g := gomega.NewGomegaWithT(t)
+
+	taskID := xid.New().String()
+	taskRunner.Execute(ctx, logr.Discard(), "power action: status", taskID, func(_ context.Context, _ chan repository.StatusMessage) (task.Result, error) {
+		return task.Result{
+			Text: "off",
+			Typed: &v1.TaskResult{Result: &v1.TaskResult_Power{Power: &v1.PowerResult{
+				PowerAction: v1.PowerAction_POWER_ACTION_STATUS,
+				State:       v1.PowerState_POWER_STATE_OFF,
+				RawState:    "off",
+			}}},
+		}, nil
+	})
+	stream := &watchStream{ctx: ctx}
+	g.Expect(taskSvc.Watch(&v1.WatchRequest{TaskId: taskID}, stream)).To(gomega.Succeed())
+
+	resp, err := taskSvc.Status(ctx, &v1.StatusRequest{TaskId: taskID})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(resp.Result).To(gomega.Equal("off"))
+	g.Expect(resp.TypedResult.GetPower().GetState()).To(gomega.Equal(v1.PowerState_POWER_STATE_OFF))
+	g.Expect(resp.TypedResult.GetPower().GetPowerAction()).To(gomega.Equal(v1.PowerAction_POWER_ACTION_STATUS))
+	g.Expect(resp.TypedResult.GetPower().GetRawState()).To(gomega.Equal("off"))
+}
+
+func TestTaskTimeout(t *testing.T) {
+	testCases := map[string]struct {
+		timeoutMs int32
+		max       time.Duration
+		want      time.Duration
+	}{
+		"default":             {want: 2 * time.Minute},
+		"requested":           {timeoutMs: 5000, max: time.Hour, want: 5 * time.Second},
+		"above maximum":       {timeoutMs: 7200000, max: time.Hour, want: time.Hour},
+		"no maximum":          {timeoutMs: 7200000, want: 2 * time.Hour},
+		"longer than default": {timeoutMs: 600000, max: time.Hour, want: 10 * time.Minute},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			in := &v1.PowerRequest{TimeoutMs: tc.timeoutMs}
+			if got := taskTimeout(2*time.Minute, tc.max, in); got != tc.want {
+				t.Fatalf("expected %v, got: %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTaskOptionsScheduledRerun(t *testing.T) {
+	testCases := map[string]struct {
+		notBefore *timestamppb.Timestamp
+		want      bool
+	}{
+		"no schedule": {},
+		"due":         {notBefore: timestamppb.New(time.Now().Add(-time.Minute))},
+		"scheduled":   {notBefore: timestamppb.New(time.Now().Add(time.Hour)), want: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			in := &v1.ResetRequest{ResetKind: v1.ResetKind_RESET_KIND_COLD, NotBefore: tc.notBefore}
+			opts, err := taskOptions(context.Background(), CallbackPolicy{}, ResetRerun, in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var o task.Options
+			for _, opt := range opts {
+				opt(&o)
+			}
+			if (o.Rerun != nil) != tc.want {
+				t.Fatalf("expected a stored request: %v, got: %+v", tc.want, o.Rerun)
+			}
+			if !tc.want {
+				return
+			}
+			if o.Rerun.Kind != ResetRerun || !o.Rerun.UntilStarted {
+				t.Fatalf("unexpected rerun: %+v", o.Rerun)
+			}
+
+			var got *v1.ResetRequest
+			rerun := rerunFunc(func(_ context.Context, _ logr.Logger, in *v1.ResetRequest) func(context.Context, chan repository.StatusMessage) (task.Result, error) {
+				got = in
+				return nil
+			})
+			if _, err := rerun(logr.Discard(), o.Rerun.Request); err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(got, in) {
+				t.Fatalf("expected the request to be rebuilt as %v, got: %v", in, got)
+			}
+		})
+	}
+}
+
+func TestTaskOptionsCallbackURL(t *testing.T) {
+	lookup := func(_ context.Context, host string) ([]net.IPAddr, error) {
+		addrs := map[string][]string{
+			"hooks.example.com": {"203.0.113.10"},
+			"internal.example":  {"10.1.1.2"},
+			"localhost":         {"127.0.0.1", "::1"},
+			"mixed.example.com": {"203.0.113.10", "169.254.169.254"},
+		}[host]
+		if addrs == nil {
+			return nil, errors.New("no such host")
+		}
+		var ips []net.IPAddr
+		for _, a := range addrs {
+			ips = append(ips, net.IPAddr{IP: net.ParseIP(a)})
+		}
+		return ips, nil
+	}
+	testCases := map[string]struct {
+		url       string
+		allowlist []string
+		wantErr   bool
+	}{
+		"none":                    {},
+		"http":                    {url: "http://10.1.1.1:8080/tasks"},
+		"https":                   {url: "HTTPS://HOOKS.example.com/pbnj?tenant=1"},
+		"relative":                {url: "/tasks", wantErr: true},
+		"no scheme":               {url: "hooks.example.com/tasks", wantErr: true},
+		"other scheme":            {url: "ftp://hooks.example.com/tasks", wantErr: true},
+		"no host":                 {url: "http:///tasks", wantErr: true},
+		"invalid":                 {url: "http://[::1", wantErr: true},
+		"opaque scheme":           {url: "mailto:ops@example.com", wantErr: true},
+		"loopback":                {url: "http://127.0.0.1:8080/tasks", wantErr: true},
+		"ipv6 loopback":           {url: "http://[::1]/tasks", wantErr: true},
+		"link-local":              {url: "http://169.254.169.254/latest/meta-data", wantErr: true},
+		"unspecified":             {url: "http://0.0.0.0/tasks", wantErr: true},
+		"name resolving loopback": {url: "http://localhost:8080/tasks", wantErr: true},
+		"any address disallowed":  {url: "https://mixed.example.com/tasks", wantErr: true},
+		"unresolvable":            {url: "https://missing.example.com/tasks", wantErr: true},
+		"allowed host":            {url: "http://LOCALHOST:8080/tasks", allowlist: []string{"localhost"}},
+		"allowed ip":              {url: "http://127.0.0.1/tasks", allowlist: []string{"127.0.0.1"}},
+		"allowed network":         {url: "http://internal.example/tasks", allowlist: []string{"10.0.0.0/8"}},
+		"outside allowlist":       {url: "https://hooks.example.com/tasks", allowlist: []string{"10.0.0.0/8", "localhost"}, wantErr: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			callbacks, err := NewCallbackPolicy(tc.allowlist)
+			if err != nil {
+				t.Fatal(err)
+			}
+			callbacks.lookup = lookup
+			_, err = taskOptions(context.Background(), callbacks, PowerRerun, &v1.PowerRequest{CallbackUrl: tc.url})
+			if tc.wantErr {
+				if status.Code(err) != codes.InvalidArgument {
+					t.Fatalf("expected an InvalidArgument error, got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestNewCallbackPolicy(t *testing.T) {
+	if _, err := NewCallbackPolicy([]string{"10.0.0.0/33"}); err == nil {
+		t.Fatal("expected an invalid CIDR to be rejected")
+	}
+	p, err := NewCallbackPolicy([]string{"", " "})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.hosts != nil || p.networks != nil {
+		t.Fatalf("expected empty entries to leave the default policy, got: %+v", p)
+	}
+}
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"maps"
 	"net"
 	"os"
 	"os/signal"
@@ -16,9 +17,11 @@ import (
 	"github.com/tinkerbell/pbnj/grpc/persistence"
 	"github.com/tinkerbell/pbnj/grpc/rpc"
 	"github.com/tinkerbell/pbnj/grpc/taskrunner"
+	"github.com/tinkerbell/pbnj/grpc/webhook"
 	"github.com/tinkerbell/pbnj/pkg/healthcheck"
 	"github.com/tinkerbell/pbnj/pkg/http"
 	"github.com/tinkerbell/pbnj/pkg/repository"
+	"github.com/tinkerbell/pbnj/pkg/task"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health/grpc_health_v1"
 )
@@ -27,6 +30,8 @@ import (
 type Server struct {
 	repository.Actions
 	bmcTimeout time.Duration
+	// maxBmcTimeout caps the timeout a request can ask for, zero means no cap.
+	maxBmcTimeout time.Duration
 	// skipRedfishVersions is a list of Redfish versions to be ignored,
 	//
 	// When running an action on a BMC, PBnJ will pass the value of the skipRedfishVersions to bmclib
@@ -35,6 +40,34 @@ type Server struct {
 	//
 	// for more information see https://github.com/bmc-toolbox/bmclib#bmc-connections
 	skipRedfishVersions []string
+	// taskRetention and failedTaskRetention are how long completed and failed
+	// task records are kept before being reaped. Zero keeps them.
+	taskRetention       time.Duration
+	failedTaskRetention time.Duration
+	// taskReapInterval is how often expired task records are looked for.
+	taskReapInterval time.Duration
+	// retryPolicy is the default retry policy for BMC tasks.
+	retryPolicy task.RetryPolicy
+	// maxWorkers caps the number of tasks running at once, zero means no limit.
+	maxWorkers int
+	// maxWorkersPerHost caps the number of tasks running at once against one BMC, zero means no limit.
+	maxWorkersPerHost int
+	// idempotencyWindow is how long a task's idempotency key is honoured, zero for as long as its record is kept.
+	idempotencyWindow time.Duration
+	// runnerID identifies this server as the owner of the tasks it starts.
+	runnerID string
+	// leaseDuration is how long this server's claim on a running task lasts unless renewed, zero disables leases.
+	leaseDuration time.Duration
+	// rerunOrphanedTasks runs tasks that are safe to repeat again after a restart, instead of aborting them.
+	rerunOrphanedTasks bool
+	// callbacks restricts the callback URLs of task requests.
+	callbacks rpc.CallbackPolicy
+	// taskRequestKey encrypts the requests stored with tasks, none are stored without it.
+	taskRequestKey []byte
+	// webhook POSTs the final status of tasks to their callback URL or its default URL.
+	webhook *webhook.Notifier
+	// eventSinks receive the lifecycle events of tasks.
+	eventSinks []taskrunner.EventSink
 }
 
 // ServerOption for setting optional values.
@@ -50,11 +83,100 @@ func WithBmcTimeout(t time.Duration) ServerOption {
 	return func(args *Server) { args.bmcTimeout = t }
 }
 
+// WithMaxBmcTimeout sets the longest timeout for BMC calls a request can ask for.
+func WithMaxBmcTimeout(t time.Duration) ServerOption {
+	return func(args *Server) { args.maxBmcTimeout = t }
+}
+
 // WithSkipRedfishVersions sets the Redfish versions to ignore for BMC calls.
 func WithSkipRedfishVersions(versions []string) ServerOption {
 	return func(args *Server) { args.skipRedfishVersions = versions }
 }
 
+// WithTaskRetention sets how long completed and failed task records are kept.
+func WithTaskRetention(completed, failed time.Duration) ServerOption {
+	return func(args *Server) {
+		args.taskRetention = completed
+		args.failedTaskRetention = failed
+	}
+}
+
+// WithTaskReapInterval sets how often expired task records are deleted.
+func WithTaskReapInterval(t time.Duration) ServerOption {
+	return func(args *Server) { args.taskReapInterval = t }
+}
+
+// WithMaxWorkers sets the number of tasks that can run at once. Further tasks are queued.
+func WithMaxWorkers(n int) ServerOption {
+	return func(args *Server) { args.maxWorkers = n }
+}
+
+// WithMaxWorkersPerHost sets the number of tasks that can run at once against the same BMC host.
+// Further tasks against the host are queued.
+func WithMaxWorkersPerHost(n int) ServerOption {
+	return func(args *Server) { args.maxWorkersPerHost = n }
+}
+
+// WithIdempotencyWindow sets how long after a task is started that a repeated
+// request with the same idempotency key returns it instead of starting a new task.
+func WithIdempotencyWindow(t time.Duration) ServerOption {
+	return func(args *Server) { args.idempotencyWindow = t }
+}
+
+// WithRunnerID sets the ID that identifies this server as the owner of the tasks it starts.
+// Servers sharing a persistence backend need distinct IDs that stay the same across restarts.
+func WithRunnerID(id string) ServerOption {
+	return func(args *Server) { args.runnerID = id }
+}
+
+// WithLeaseDuration sets how long this server's claim on each task it runs lasts unless renewed.
+// Servers sharing a persistence backend take over the incomplete tasks of servers whose leases
+// expired, rerunning or aborting them like orphaned tasks after a restart. Zero disables leases.
+func WithLeaseDuration(t time.Duration) ServerOption {
+	return func(args *Server) { args.leaseDuration = t }
+}
+
+// WithRerunOrphanedTasks sets whether tasks that are safe to repeat, such as power status,
+// are run again after a restart. Their requests, including credentials, are stored with
+// them until they complete, encrypted with the key set by WithTaskRequestKey, so it needs
+// one. Other incomplete tasks are aborted on startup either way.
+func WithRerunOrphanedTasks(rerun bool) ServerOption {
+	return func(args *Server) { args.rerunOrphanedTasks = rerun }
+}
+
+// WithCallbackPolicy sets the callback URLs task requests may set, see rpc.NewCallbackPolicy.
+// By default any URL whose host addresses are not loopback or link-local is allowed.
+func WithCallbackPolicy(p rpc.CallbackPolicy) ServerOption {
+	return func(args *Server) { args.callbacks = p }
+}
+
+// WithTaskRequestKey sets the AES key, of 16, 24 or 32 bytes, the requests stored with
+// scheduled tasks and tasks rerun after a restart are encrypted with. Without one no
+// requests are stored, and those tasks are aborted if the server restarts before they start.
+func WithTaskRequestKey(key []byte) ServerOption {
+	return func(args *Server) { args.taskRequestKey = key }
+}
+
+// WithWebhook POSTs the final status of tasks started without a callback URL to url,
+// signed with secret, and sets how failed deliveries to any URL are retried.
+func WithWebhook(url string, secret []byte, p task.RetryPolicy) ServerOption {
+	return func(args *Server) {
+		args.webhook.URL = url
+		args.webhook.Secret = secret
+		args.webhook.RetryPolicy = p
+	}
+}
+
+// WithEventSinks sends the lifecycle events of tasks to the sinks.
+func WithEventSinks(sinks ...taskrunner.EventSink) ServerOption {
+	return func(args *Server) { args.eventSinks = append(args.eventSinks, sinks...) }
+}
+
+// WithRetryPolicy sets the default retry policy for BMC tasks.
+func WithRetryPolicy(p task.RetryPolicy) ServerOption {
+	return func(args *Server) { args.retryPolicy = p }
+}
+
 // RunServer registers all services and runs the server.
 func RunServer(ctx context.Context, log logr.Logger, grpcServer *grpc.Server, port string, httpServer *http.Server, opts ...ServerOption) error {
 	ctx, cancel := context.WithCancel(ctx)
@@ -69,8 +191,10 @@ func RunServer(ctx context.Context, log logr.Logger, grpcServer *grpc.Server, po
 	}
 
 	defaultServer := &Server{
-		Actions:    repo,
-		bmcTimeout: oob.DefaultBMCTimeout,
+		Actions:          repo,
+		bmcTimeout:       oob.DefaultBMCTimeout,
+		taskReapInterval: taskrunner.DefaultReapInterval,
+		webhook:          &webhook.Notifier{},
 	}
 
 	for _, opt := range opts {
@@ -78,34 +202,67 @@ func RunServer(ctx context.Context, log logr.Logger, grpcServer *grpc.Server, po
 	}
 
 	taskRunner := &taskrunner.Runner{
-		Repository: defaultServer.Actions,
-		Ctx:        ctx,
+		Repository:        defaultServer.Actions,
+		Ctx:               ctx,
+		Retention:         defaultServer.taskRetention,
+		FailedRetention:   defaultServer.failedTaskRetention,
+		RetryPolicy:       defaultServer.retryPolicy,
+		MaxWorkers:        defaultServer.maxWorkers,
+		MaxWorkersPerHost: defaultServer.maxWorkersPerHost,
+		IdempotencyWindow: defaultServer.idempotencyWindow,
+		ID:                defaultServer.runnerID,
+		LeaseDuration:     defaultServer.leaseDuration,
+		RequestKey:        defaultServer.taskRequestKey,
 	}
+	defaultServer.webhook.Log = log
+	defaultServer.webhook.Ctx = ctx
+	taskRunner.EventSinks = append(defaultServer.eventSinks, defaultServer.webhook)
+	go taskRunner.Reap(ctx, log, defaultServer.taskReapInterval)
 
 	ms := rpc.MachineService{
-		TaskRunner: taskRunner,
-		Timeout:    defaultServer.bmcTimeout,
+		TaskRunner:       taskRunner,
+		Timeout:          defaultServer.bmcTimeout,
+		MaxTimeout:       defaultServer.maxBmcTimeout,
+		RerunPowerStatus: defaultServer.rerunOrphanedTasks,
+		Callbacks:        defaultServer.callbacks,
 	}
 	v1.RegisterMachineServer(grpcServer, &ms)
 
 	bs := rpc.BmcService{
 		TaskRunner:          taskRunner,
 		Timeout:             defaultServer.bmcTimeout,
+		MaxTimeout:          defaultServer.maxBmcTimeout,
 		SkipRedfishVersions: defaultServer.skipRedfishVersions,
+		Callbacks:           defaultServer.callbacks,
 	}
 	v1.RegisterBMCServer(grpcServer, &bs)
 
 	ds := rpc.DiagnosticService{
 		TaskRunner: taskRunner,
 		Timeout:    defaultServer.bmcTimeout,
+		MaxTimeout: defaultServer.maxBmcTimeout,
+		Callbacks:  defaultServer.callbacks,
 	}
 	v1.RegisterDiagnosticServer(grpcServer, &ds)
 
+	// scheduled tasks of every kind are started after a restart, the ones safe to
+	// repeat are only stored for that with rerunOrphanedTasks. Either needs taskRequestKey.
+	taskRunner.Reruns = make(map[string]taskrunner.RerunFunc)
+	for _, reruns := range []map[string]taskrunner.RerunFunc{ms.Reruns(), bs.Reruns(), ds.Reruns()} {
+		maps.Copy(taskRunner.Reruns, reruns)
+	}
+
 	ts := rpc.TaskService{
 		TaskRunner: taskRunner,
 	}
 	v1.RegisterTaskServer(grpcServer, &ts)
 
+	// finish the tasks left incomplete by a previous run before serving new ones.
+	if err := taskRunner.Recover(log); err != nil {
+		log.Error(err, "unable to recover incomplete tasks")
+	}
+	go taskRunner.Heartbeat(ctx, log)
+
 	grpc_prometheus.Register(grpcServer)
 
 	hc := healthcheck.NewHealthChecker()
@@ -0,0 +1,61 @@
+package taskrunner
+
+// pool hands out up to limit slots to tasks and queues the rest, oldest first.
+// A limit of zero or less never queues. It is not safe for concurrent use.
+type pool struct {
+	limit int
+	// holders are the IDs of the tasks holding a slot, oldest first.
+	holders []string
+	queue   []waiter
+}
+
+type waiter struct {
+	taskID string
+	ready  chan struct{}
+}
+
+// acquire takes a slot for the task if one is free and no task is queued for one.
+// Otherwise the task joins the queue and ready is closed once a slot is handed to it.
+func (p *pool) acquire(taskID string) (ready chan struct{}, queued bool) {
+	ready = make(chan struct{})
+	if p.limit <= 0 || (len(p.holders) < p.limit && len(p.queue) == 0) {
+		p.holders = append(p.holders, taskID)
+		close(ready)
+		return ready, false
+	}
+	p.queue = append(p.queue, waiter{taskID: taskID, ready: ready})
+	return ready, true
+}
+
+// release frees the task's slot and hands it to the oldest queued task.
+func (p *pool) release(taskID string) {
+	for i, id := range p.holders {
+		if id == taskID {
+			p.holders = append(p.holders[:i], p.holders[i+1:]...)
+			break
+		}
+	}
+	if len(p.queue) > 0 && (p.limit <= 0 || len(p.holders) < p.limit) {
+		next := p.queue[0]
+		p.queue = p.queue[1:]
+		p.holders = append(p.holders, next.taskID)
+		close(next.ready)
+	}
+}
+
+// dequeue removes the task from the queue. It returns false if the task
+// is not queued, i.e. a slot was already handed to it.
+func (p *pool) dequeue(taskID string) bool {
+	for i, w := range p.queue {
+		if w.taskID == taskID {
+			p.queue = append(p.queue[:i], p.queue[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// idle reports whether no task holds or waits for a slot.
+func (p *pool) idle() bool {
+	return len(p.holders) == 0 && len(p.queue) == 0
+}
@@ -0,0 +1,69 @@
+package taskrunner
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/tinkerbell/pbnj/pkg/repository"
+)
+
+// errNoRequestKey is returned when a task's request can't be stored because the Runner has no RequestKey.
+var errNoRequestKey = errors.New("no request key is set to encrypt it with")
+
+// sealRerun returns the rerun with its request encrypted with RequestKey, so that the
+// credentials the request holds are not stored in the clear. The task ID is
+// authenticated with it, so the request can't be moved to another task's record.
+func (r *Runner) sealRerun(taskID string, rerun *repository.Rerun) (*repository.Rerun, error) {
+	if len(r.RequestKey) == 0 {
+		return nil, errNoRequestKey
+	}
+	aead, err := requestCipher(r.RequestKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return &repository.Rerun{
+		Kind:         rerun.Kind,
+		Sealed:       aead.Seal(nonce, nonce, rerun.Request, []byte(taskID)),
+		UntilStarted: rerun.UntilStarted,
+	}, nil
+}
+
+// openRerun returns the request of a rerun sealed with sealRerun.
+func (r *Runner) openRerun(taskID string, rerun *repository.Rerun) ([]byte, error) {
+	if len(rerun.Sealed) == 0 {
+		// stored before requests were encrypted.
+		return rerun.Request, nil
+	}
+	if len(r.RequestKey) == 0 {
+		return nil, errNoRequestKey
+	}
+	aead, err := requestCipher(r.RequestKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(rerun.Sealed) < aead.NonceSize() {
+		return nil, errors.New("stored request is too short")
+	}
+	nonce, sealed := rerun.Sealed[:aead.NonceSize()], rerun.Sealed[aead.NonceSize():]
+	request, err := aead.Open(nil, nonce, sealed, []byte(taskID))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to decrypt stored request")
+	}
+	return request, nil
+}
+
+// requestCipher returns the AES-GCM cipher of a request key.
+func requestCipher(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid request key: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
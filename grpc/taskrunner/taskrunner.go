@@ -2,8 +2,13 @@ package taskrunner
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"net"
 	"net/url"
+	"sort"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -12,19 +17,123 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/pkg/errors"
 
+	v1 "github.com/tinkerbell/pbnj/api/v1"
+	"github.com/tinkerbell/pbnj/pkg/logging"
 	"github.com/tinkerbell/pbnj/pkg/metrics"
 	"github.com/tinkerbell/pbnj/pkg/repository"
+	"github.com/tinkerbell/pbnj/pkg/task"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
+const (
+	// DefaultReapInterval is how often expired task records are looked for by default.
+	DefaultReapInterval = time.Minute
+	// watchPollInterval is how often Watch reads the record of a task that is
+	// not running in this Runner, e.g. one started by another replica.
+	watchPollInterval = time.Second
+	// messageBuffer is the number of status messages an action can send while
+	// the previous ones are being written to the repository.
+	messageBuffer = 64
+	// maxUpdateAttempts is how many times a task's record is written before
+	// giving up when other writers keep changing it in between.
+	maxUpdateAttempts = 5
+)
+
+// errSuperseded is returned when the record of a task can't be updated because
+// it was completed, or taken over by another owner, since it was read.
+var errSuperseded = errors.New("task record was completed or taken over by another writer")
+
 // Runner for executing a task.
 type Runner struct {
 	Repository repository.Actions
 	Ctx        context.Context
-	active     int
-	total      int
-	counterMu  sync.RWMutex
+	// Retention is how long records of successfully completed tasks are kept
+	// before the reaper deletes them. Zero keeps them until the store evicts them.
+	Retention time.Duration
+	// FailedRetention is how long records of failed tasks are kept
+	// before the reaper deletes them. Zero keeps them until the store evicts them.
+	FailedRetention time.Duration
+	// RetryPolicy is the default retry policy for task actions.
+	// It can be overridden per task with task.WithRetryPolicy.
+	RetryPolicy task.RetryPolicy
+	// MaxWorkers caps the number of tasks running at once. Tasks started beyond
+	// it are recorded as "queued" and run in the order they were started.
+	// Zero means no limit.
+	MaxWorkers int
+	// MaxWorkersPerHost caps the number of tasks running at once against the same
+	// BMC host. Tasks started beyond it are recorded as "queued", with a message
+	// naming the tasks they wait for. Zero means no limit.
+	MaxWorkersPerHost int
+	// ID identifies the Runner as the owner of the tasks it starts, so that Recover
+	// only finishes its own tasks. Runners sharing a repository need distinct IDs
+	// that stay the same across restarts.
+	ID string
+	// LeaseDuration is how long the Runner's claim on each task it runs lasts unless
+	// renewed. When set, Heartbeat renews the leases of the Runner's tasks and takes
+	// over the incomplete tasks of other Runners whose leases expired, e.g. because
+	// their replica stopped. Zero disables leases.
+	LeaseDuration time.Duration
+	// Reruns rebuilds the actions of tasks started with task.WithRerun, keyed by rerun kind.
+	// Recover runs incomplete tasks of these kinds again instead of aborting them.
+	Reruns map[string]RerunFunc
+	// RequestKey is the AES key, of 16, 24 or 32 bytes, that the requests stored with
+	// task.WithRerun and task.WithScheduledRerun are encrypted with, as they hold BMC
+	// credentials. Without it no requests are stored, so every task left incomplete by
+	// a restart is aborted.
+	RequestKey []byte
+	// EventSinks receive the lifecycle events of each task.
+	EventSinks []EventSink
+	// IdempotencyWindow is how long after a task is created that its idempotency
+	// key is honoured, after which the store expires the key's claim. Zero honours
+	// it until the task's record is reaped.
+	IdempotencyWindow time.Duration
+	active            int
+	total             int
+	counterMu         sync.RWMutex
+	// workers hands out the MaxWorkers slots, hosts the MaxWorkersPerHost slots of each host.
+	workers pool
+	hosts   map[string]*pool
+	poolMu  sync.Mutex
+	// cancels holds the cancel funcs of running tasks, keyed by task ID.
+	cancels map[string]context.CancelFunc
+	// leases holds the IDs of the running tasks whose record is written, so has a lease to renew.
+	leases   map[string]struct{}
+	cancelMu sync.Mutex
+	// watchers holds the channels of Watch calls on running tasks, keyed by task ID.
+	watchers map[string]map[chan repository.Record]struct{}
+	// published holds the last record published of each running task, keyed by task ID.
+	published map[string]repository.Record
+	watchMu   sync.Mutex
+}
+
+// Task lifecycle event types.
+const (
+	EventCreated   = "created"
+	EventStarted   = "started"
+	EventMessage   = "message"
+	EventCompleted = "completed"
+	EventFailed    = "failed"
+)
+
+// Event is a transition in the lifecycle of a task.
+type Event struct {
+	Type string
+	Time time.Time
+	// Record is the task's record as of the event.
+	Record repository.Record
+	// Message is the status message of an EventMessage.
+	Message *repository.StatusMessage
+}
+
+// EventSink receives task lifecycle events, in order for each task. Emit must not block.
+type EventSink interface {
+	Emit(e Event)
 }
 
+// RerunFunc rebuilds the action of a task from the request stored with task.WithRerun.
+type RerunFunc func(l logr.Logger, request []byte) (func(context.Context, chan repository.StatusMessage) (task.Result, error), error)
+
 // ActiveWorkers returns a count of currently active worker jobs.
 func (r *Runner) ActiveWorkers() int {
 	r.counterMu.RLock()
@@ -39,41 +148,311 @@ func (r *Runner) TotalWorkers() int {
 	return r.total
 }
 
+// QueuedTasks returns a count of tasks waiting for a worker slot.
+func (r *Runner) QueuedTasks() int {
+	r.poolMu.Lock()
+	defer r.poolMu.Unlock()
+	n := len(r.workers.queue)
+	for _, hp := range r.hosts {
+		n += len(hp.queue)
+	}
+	return n
+}
+
 // Execute a task, update repository with status.
-func (r *Runner) Execute(ctx context.Context, l logr.Logger, description, taskID string, action func(chan string) (string, error)) {
-	go r.worker(ctx, l, description, taskID, action)
+// A task with a NotBefore option in the future is recorded as "scheduled" and
+// waits until then, without holding a worker slot.
+// When MaxWorkers tasks, or MaxWorkersPerHost tasks against the same host, are already
+// running, the task is queued until they finish.
+// The action is passed a context that is cancelled when the task is cancelled.
+// It is not derived from ctx, as the task outlives the request that started it,
+// but the request ID and otel trace ID in ctx are recorded with the task.
+// If a task with the same description and host was already started with the same
+// idempotency key within the IdempotencyWindow, nothing is started and that task's
+// ID is returned.
+func (r *Runner) Execute(ctx context.Context, l logr.Logger, description, taskID string, action func(context.Context, chan repository.StatusMessage) (task.Result, error), opts ...task.Option) string {
+	o := task.Options{RequestID: logging.RequestID(ctx)}
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		o.TraceID = sc.TraceID().String()
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.Rerun != nil {
+		rerun, err := r.sealRerun(taskID, o.Rerun)
+		if err != nil {
+			l.Info("not storing the task's request, so it is aborted rather than run after a restart", "reason", err.Error())
+		}
+		o.Rerun = rerun
+	}
+	if o.IdempotencyKey != "" {
+		id, err := r.Repository.ClaimKey(idempotencyScope(description, o.Host, o.IdempotencyKey), taskID, r.IdempotencyWindow)
+		switch {
+		case err != nil:
+			// Starting the task is preferred over failing the request when the store is unavailable.
+			l.Error(err, "unable to claim idempotency key, starting a new task")
+		case id != taskID:
+			l.Info("task already started with the same idempotency key", "existingTaskID", id)
+			return id
+		}
+	}
+	r.start(l, description, taskID, action, o, 0)
+	return taskID
+}
+
+// start runs the action in a worker. The task's record is created with version,
+// which must be above the version of any earlier record of the task, so that a
+// previous owner can't update the new record.
+func (r *Runner) start(l logr.Logger, description, taskID string, action func(context.Context, chan repository.StatusMessage) (task.Result, error), o task.Options, version int64) {
+	taskCtx, cancel := context.WithCancel(context.Background())
+	r.cancelMu.Lock()
+	if r.cancels == nil {
+		r.cancels = make(map[string]context.CancelFunc)
+	}
+	r.cancels[taskID] = cancel
+	r.cancelMu.Unlock()
+	r.watchMu.Lock()
+	if r.watchers == nil {
+		r.watchers = make(map[string]map[chan repository.Record]struct{})
+	}
+	r.watchers[taskID] = make(map[chan repository.Record]struct{})
+	r.watchMu.Unlock()
+	// scheduled tasks reserve their slots once they are due.
+	var res *reservation
+	if !o.NotBefore.After(time.Now()) {
+		res = r.reserve(taskID, o.Host)
+	}
+	go r.worker(taskCtx, l, description, taskID, action, o, res, version)
+}
+
+// idempotencyScope returns the key claimed for a task's idempotency key, which
+// is scoped to the task's description and host so that a key reused for another
+// request starts that request.
+func idempotencyScope(description, host, key string) string {
+	sum := sha256.Sum256([]byte(description + "\x00" + host + "\x00" + key))
+	return hex.EncodeToString(sum[:])
+}
+
+// Cancel a scheduled, queued or running task. The context passed to the task's action
+// is cancelled and the task is recorded with a "cancelled" state once the action returns.
+// A task cancelled before it starts is recorded as "cancelled" without running its action.
+// A task that another Runner owns is marked with CancelRequested, and that Runner cancels
+// it when it next renews the task's lease. Without leases that can't happen, so
+// task.ErrRunningElsewhere is returned instead.
+func (r *Runner) Cancel(ctx context.Context, taskID string) error {
+	r.cancelMu.Lock()
+	cancel, ok := r.cancels[taskID]
+	r.cancelMu.Unlock()
+	if ok {
+		cancel()
+		return nil
+	}
+	for attempt := 1; ; attempt++ {
+		rec, err := r.Status(ctx, taskID)
+		if err != nil {
+			return err
+		}
+		if rec.Complete || rec.Owner == "" || rec.Owner == r.ID {
+			return task.ErrNotRunning
+		}
+		if r.LeaseDuration <= 0 {
+			return fmt.Errorf("%w: it is owned by %v", task.ErrRunningElsewhere, rec.Owner)
+		}
+		if rec.CancelRequested {
+			return nil
+		}
+		rec.CancelRequested = true
+		err = r.Repository.Update(taskID, rec)
+		if !errors.Is(err, repository.ErrConflict) || attempt >= maxUpdateAttempts {
+			return err
+		}
+		metrics.TaskUpdateConflicts.Inc()
+	}
+}
+
+// Watch returns a channel that receives the record of a task each time it changes.
+// The current record is sent first. The channel is closed after the task's final
+// record is sent, or when ctx is done. A receiver that falls behind only misses
+// intermediate records; each record holds all messages so far.
+func (r *Runner) Watch(ctx context.Context, taskID string) (<-chan repository.Record, error) {
+	ch := make(chan repository.Record, 1)
+	// the record is read before locking, so a slow store doesn't hold up the workers publishing records.
+	record, err := r.Repository.Get(taskID)
+	r.watchMu.Lock()
+	if watchers, ok := r.watchers[taskID]; ok {
+		// a record published since the read is newer. The record may not be created
+		// yet either, the worker publishes it once it is.
+		if last, ok := r.published[taskID]; ok && (err != nil || last.Version >= record.Version) {
+			record, err = last, nil
+		}
+		if err == nil {
+			ch <- record
+		}
+		watchers[ch] = struct{}{}
+		r.watchMu.Unlock()
+		go func() {
+			<-ctx.Done()
+			r.unwatch(taskID, ch)
+		}()
+		return ch, nil
+	}
+	r.watchMu.Unlock()
+
+	if err != nil {
+		// Status describes the store's error.
+		if record, err = r.Status(ctx, taskID); err != nil {
+			return nil, err
+		}
+	}
+	go r.poll(ctx, taskID, record, ch)
+	return ch, nil
+}
+
+// poll sends the record of a task that is not running in this Runner each time it changes.
+func (r *Runner) poll(ctx context.Context, taskID string, record repository.Record, ch chan repository.Record) {
+	defer close(ch)
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ch <- record:
+		}
+		if record.Complete {
+			return
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			next, err := r.Status(ctx, taskID)
+			if err != nil {
+				return
+			}
+			if next.State != record.State || next.Complete || len(next.Messages) != len(record.Messages) {
+				record = next
+				break
+			}
+		}
+	}
+}
+
+func (r *Runner) unwatch(taskID string, ch chan repository.Record) {
+	r.watchMu.Lock()
+	defer r.watchMu.Unlock()
+	if _, ok := r.watchers[taskID][ch]; ok {
+		delete(r.watchers[taskID], ch)
+		close(ch)
+	}
+}
+
+// publish sends the record to the watchers of the task, replacing any record they have not received yet.
+// When final is set the watchers' channels are closed.
+func (r *Runner) publish(record repository.Record, final bool) {
+	record = snapshot(record)
+	r.watchMu.Lock()
+	defer r.watchMu.Unlock()
+	for ch := range r.watchers[record.ID] {
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- record
+		if final {
+			close(ch)
+		}
+	}
+	if final {
+		delete(r.watchers, record.ID)
+		delete(r.published, record.ID)
+		return
+	}
+	if _, ok := r.watchers[record.ID]; ok {
+		if r.published == nil {
+			r.published = make(map[string]repository.Record)
+		}
+		r.published[record.ID] = record
+	}
+}
+
+// emit sends an event about the task to the EventSinks.
+func (r *Runner) emit(eventType string, record repository.Record, msg *repository.StatusMessage) {
+	if len(r.EventSinks) == 0 {
+		return
+	}
+	e := Event{Type: eventType, Time: time.Now().UTC(), Record: snapshot(record), Message: msg}
+	for _, sink := range r.EventSinks {
+		sink.Emit(e)
+	}
 }
 
-// does the work, updates the repo record
-// TODO handle retrys, use a timeout.
-func (r *Runner) worker(_ context.Context, logger logr.Logger, description, taskID string, action func(chan string) (string, error)) {
+// snapshot returns a copy of the record that later changes to the original do not affect.
+func snapshot(record repository.Record) repository.Record {
+	record.Messages = append([]string(nil), record.Messages...)
+	record.StatusMessages = append([]repository.StatusMessage(nil), record.StatusMessages...)
+	if record.Error != nil {
+		e := *record.Error
+		record.Error = &e
+	}
+	return record
+}
+
+// does the work, updates the repo record.
+// A scheduled task, which has no reservation yet, waits until it is due and then
+// reserves its slots. A queued task waits for its reservation before running the action.
+func (r *Runner) worker(ctx context.Context, logger logr.Logger, description, taskID string, action func(context.Context, chan repository.StatusMessage) (task.Result, error), o task.Options, res *reservation, version int64) {
 	logger = logger.WithValues("taskID", taskID, "description", description)
-	r.counterMu.Lock()
-	r.active++
-	r.total++
-	r.counterMu.Unlock()
 	defer func() {
-		r.counterMu.Lock()
-		r.active--
-		r.counterMu.Unlock()
+		r.cancelMu.Lock()
+		r.cancels[taskID]()
+		delete(r.cancels, taskID)
+		delete(r.leases, taskID)
+		r.cancelMu.Unlock()
 	}()
-
-	metrics.TasksTotal.Inc()
-	metrics.TasksActive.Inc()
-	defer metrics.TasksActive.Dec()
-
-	messagesChan := make(chan string)
-	actionACK := make(chan bool, 1)
-	actionSyn := make(chan bool, 1)
-	defer close(messagesChan)
-	defer close(actionACK)
-	defer close(actionSyn)
 	repo := r.Repository
+	now := time.Now().UTC()
+	state := "running"
+	var startedAt time.Time
+	messages := []string{}
+	var statusMessages []repository.StatusMessage
+	switch {
+	case res == nil:
+		state = "scheduled"
+		msg := repository.NewStatusMessage(repository.LevelInfo, "", "scheduled to start at "+o.NotBefore.UTC().Format(time.RFC3339))
+		messages = append(messages, msg.Text)
+		statusMessages = append(statusMessages, msg)
+	case res.queued:
+		state = "queued"
+		msg := repository.NewStatusMessage(repository.LevelInfo, "", res.message())
+		messages = append(messages, msg.Text)
+		statusMessages = append(statusMessages, msg)
+	default:
+		startedAt = now
+	}
+	rerun := o.Rerun
+	if !startedAt.IsZero() {
+		rerun = startedRerun(rerun)
+	}
 	sessionRecord := repository.Record{
-		ID:          taskID,
-		Description: description,
-		State:       "running",
-		Messages:    []string{},
+		ID:             taskID,
+		Description:    description,
+		State:          state,
+		Messages:       messages,
+		StatusMessages: statusMessages,
+		Host:           o.Host,
+		IdempotencyKey: o.IdempotencyKey,
+		Owner:          r.ID,
+		Rerun:          rerun,
+		CallbackURL:    o.CallbackURL,
+		RequestID:      o.RequestID,
+		TraceID:        o.TraceID,
+		CreatedAt:      now,
+		NotBefore:      o.NotBefore,
+		StartedAt:      startedAt,
+		Version:        version,
 		Error: &repository.Error{
 			Code:    0,
 			Message: "",
@@ -81,35 +460,80 @@ func (r *Runner) worker(_ context.Context, logger logr.Logger, description, task
 		},
 	}
 
+	if r.LeaseDuration > 0 {
+		sessionRecord.LeaseExpiresAt = now.Add(r.LeaseDuration)
+	}
+
 	err := repo.Create(taskID, sessionRecord)
 	if err != nil {
 		// TODO how to handle unable to create record; ie network error, persistence error, etc?
 		logger.Error(err, "task complete", "complete", true)
+		if o.IdempotencyKey != "" {
+			// the task never started, so a repeat of the request may start it.
+			if err := repo.ReleaseKey(idempotencyScope(description, o.Host, o.IdempotencyKey), taskID); err != nil {
+				logger.Error(err, "unable to release idempotency key")
+			}
+		}
+		if res != nil {
+			r.unreserve(res)
+		}
+		r.publish(sessionRecord, true)
 		return
 	}
+	// the task's lease is only renewed once its record exists.
+	r.cancelMu.Lock()
+	if r.leases == nil {
+		r.leases = make(map[string]struct{})
+	}
+	r.leases[taskID] = struct{}{}
+	r.cancelMu.Unlock()
+	r.publish(sessionRecord, false)
+	r.emit(EventCreated, sessionRecord, nil)
+	if sessionRecord.State == "running" {
+		r.emit(EventStarted, sessionRecord, nil)
+	}
 
-	go func() {
-		for {
-			select {
-			case msg := <-messagesChan:
-				currStatus, _ := repo.Get(taskID)
-				sessionRecord.Messages = append(currStatus.Messages, msg) //nolint:gocritic // apparently this is the right slice
-				_ = repo.Update(taskID, sessionRecord)
-			case <-actionSyn:
-				actionACK <- true
-				return
-			default:
+	var result task.Result
+	if res == nil {
+		err = r.waitUntil(ctx, o.NotBefore)
+		if err == nil {
+			res = r.reserve(taskID, o.Host)
+			if res.queued {
+				sessionRecord.State = "queued"
+				sessionRecord.AddMessage(repository.NewStatusMessage(repository.LevelInfo, "", res.message()))
+				_ = r.update(&sessionRecord)
+				r.publish(sessionRecord, false)
 			}
-			time.Sleep(10 * time.Millisecond)
 		}
-	}()
-
-	sessionRecord.Result, err = action(messagesChan)
-	actionSyn <- true
-	<-actionACK
+	}
+	if err == nil && res.queued {
+		err = r.wait(ctx, res)
+	}
+	if err == nil && sessionRecord.State != "running" {
+		sessionRecord.State = "running"
+		sessionRecord.StartedAt = time.Now().UTC()
+		sessionRecord.Rerun = startedRerun(sessionRecord.Rerun)
+		_ = r.update(&sessionRecord)
+		r.publish(sessionRecord, false)
+		r.emit(EventStarted, sessionRecord, nil)
+	}
+	if err == nil {
+		defer r.unreserve(res)
+		result, err = r.run(ctx, logger, action, o, &sessionRecord)
+	}
+	sessionRecord.Result = result.Text
 	sessionRecord.State = "complete"
 	sessionRecord.Complete = true
+	sessionRecord.Rerun = nil
+	sessionRecord.FinishedAt = time.Now().UTC()
 	var finalErr error
+	if err == nil && result.Typed != nil {
+		typed, merr := protojson.Marshal(result.Typed)
+		if merr != nil {
+			finalErr = multierror.Append(finalErr, errors.Wrap(merr, "unable to encode typed result"))
+		}
+		sessionRecord.TypedResult = typed
+	}
 	if err != nil {
 		finalErr = multierror.Append(finalErr, err)
 		sessionRecord.Result = "action failed"
@@ -123,10 +547,27 @@ func (r *Runner) worker(_ context.Context, logger logr.Logger, description, task
 		if errors.As(err, &foundErr) {
 			sessionRecord.Error = foundErr.StructuredError()
 		}
+		if errors.Is(ctx.Err(), context.Canceled) {
+			sessionRecord.State = "cancelled"
+			sessionRecord.Result = "action cancelled"
+			sessionRecord.AddMessage(repository.NewStatusMessage(repository.LevelWarning, "", "task cancelled"))
+			sessionRecord.Error = &repository.Error{
+				Code:    v1.Code_value["CANCELLED"],
+				Message: "task cancelled",
+				Details: []string{err.Error()},
+			}
+		}
 	}
 	// TODO handle unable to update record; ie network error, persistence error, etc
-	if err := repo.Update(taskID, sessionRecord); err != nil {
+	superseded := false
+	if err := r.update(&sessionRecord); err != nil {
 		finalErr = multierror.Append(finalErr, err)
+		superseded = errors.Is(err, errSuperseded)
+	}
+	r.publish(sessionRecord, true)
+	if !superseded {
+		// the writer that completed the task reports its outcome.
+		r.emitFinal(sessionRecord)
 	}
 
 	if finalErr != nil {
@@ -136,6 +577,531 @@ func (r *Runner) worker(_ context.Context, logger logr.Logger, description, task
 	}
 }
 
+// Recover finishes the incomplete tasks owned by this Runner, which were left
+// behind by a previous process that stopped before they completed, e.g. after a crash.
+// Tasks with a rerun function in Reruns are run again under the same ID, keeping their
+// schedule if they had not started yet, which for tasks that are not safe to repeat is
+// the only case they are run in. All others are recorded as failed with an ABORTED error.
+// It is meant to be called once on startup.
+func (r *Runner) Recover(l logr.Logger) error {
+	incomplete := false
+	records, err := r.Repository.List(repository.Filter{Complete: &incomplete})
+	if err != nil {
+		return errors.Wrap(err, "unable to list incomplete tasks")
+	}
+	var errs error
+	for _, rec := range records {
+		if rec.Owner != r.ID {
+			continue
+		}
+		r.cancelMu.Lock()
+		_, running := r.cancels[rec.ID]
+		r.cancelMu.Unlock()
+		if running {
+			continue
+		}
+		logger := l.WithValues("taskID", rec.ID, "description", rec.Description)
+		if err := r.claim(&rec, time.Now().UTC()); err != nil {
+			if !errors.Is(err, repository.ErrConflict) {
+				errs = multierror.Append(errs, errors.Wrapf(err, "unable to recover task %v", rec.ID))
+			}
+			// otherwise another Runner took it over as its lease expired.
+			continue
+		}
+		if err := r.resume(logger, rec, "the server stopped before the task completed", "task interrupted by a server restart, running it again"); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+// Heartbeat renews the leases of the tasks this Runner runs and takes over the incomplete
+// tasks of other Runners whose leases expired, every third of LeaseDuration until ctx is
+// cancelled. Tasks taken over are finished like Recover finishes orphaned tasks.
+// A task of this Runner that another Runner took over is cancelled here.
+// It is a no-op when LeaseDuration is not set.
+func (r *Runner) Heartbeat(ctx context.Context, logger logr.Logger) {
+	if r.LeaseDuration <= 0 {
+		return
+	}
+	ticker := time.NewTicker(r.LeaseDuration / 3)
+	defer ticker.Stop()
+	for {
+		now := time.Now().UTC()
+		r.renewLeases(logger, now)
+		if err := r.takeOver(logger, now); err != nil {
+			logger.Error(err, "failed to take over tasks with expired leases")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// renewLeases extends the leases of the tasks running in this Runner to LeaseDuration from now.
+func (r *Runner) renewLeases(logger logr.Logger, now time.Time) {
+	r.cancelMu.Lock()
+	ids := make([]string, 0, len(r.leases))
+	for id := range r.leases {
+		ids = append(ids, id)
+	}
+	r.cancelMu.Unlock()
+	for _, id := range ids {
+		if err := r.renew(id, now.Add(r.LeaseDuration)); err != nil {
+			logger.Error(err, "unable to renew task lease", "taskID", id)
+		}
+	}
+}
+
+// renew extends the lease of a task to expires. A task that another Runner took over
+// is cancelled, so that it is only run by its new owner, as is a task that another
+// Runner was asked to cancel.
+func (r *Runner) renew(taskID string, expires time.Time) error {
+	for attempt := 1; ; attempt++ {
+		rec, err := r.Repository.Get(taskID)
+		if err != nil {
+			return err
+		}
+		if rec.Complete {
+			return nil
+		}
+		if rec.Owner != r.ID {
+			r.cancelMu.Lock()
+			if cancel, ok := r.cancels[taskID]; ok {
+				cancel()
+			}
+			r.cancelMu.Unlock()
+			return fmt.Errorf("task was taken over by %v, cancelling it", rec.Owner)
+		}
+		if rec.CancelRequested {
+			r.cancelMu.Lock()
+			if cancel, ok := r.cancels[taskID]; ok {
+				cancel()
+			}
+			r.cancelMu.Unlock()
+		}
+		rec.LeaseExpiresAt = expires
+		err = r.Repository.Update(taskID, rec)
+		if !errors.Is(err, repository.ErrConflict) || attempt >= maxUpdateAttempts {
+			return err
+		}
+		metrics.TaskUpdateConflicts.Inc()
+	}
+}
+
+// takeOver claims the incomplete tasks of other Runners whose leases expired before now
+// and finishes them. When several Runners try to claim the same task only the first
+// one's update succeeds, the others get a version conflict and leave it.
+func (r *Runner) takeOver(l logr.Logger, now time.Time) error {
+	incomplete := false
+	records, err := r.Repository.List(repository.Filter{Complete: &incomplete})
+	if err != nil {
+		return errors.Wrap(err, "unable to list incomplete tasks")
+	}
+	var errs error
+	for _, rec := range records {
+		if rec.Owner == r.ID || rec.LeaseExpiresAt.IsZero() || now.Before(rec.LeaseExpiresAt) {
+			continue
+		}
+		previous := rec.Owner
+		logger := l.WithValues("taskID", rec.ID, "description", rec.Description, "previousOwner", previous)
+		if err := r.claim(&rec, now); err != nil {
+			if !errors.Is(err, repository.ErrConflict) {
+				errs = multierror.Append(errs, errors.Wrapf(err, "unable to take over task %v", rec.ID))
+			}
+			// otherwise another Runner claimed it first, or its owner renewed the lease.
+			continue
+		}
+		metrics.TasksTakenOver.Inc()
+		logger.Info("took over task whose owner's lease expired")
+		reason := fmt.Sprintf("its owner %v stopped renewing its lease", previous)
+		if err := r.resume(logger, rec, reason, fmt.Sprintf("task taken over from %v, running it again", previous)); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+// claim makes this Runner the owner of the task, with a new lease when leases are used.
+// The update is based on rec, so it fails with repository.ErrConflict when another
+// Runner changed the task's record, e.g. by claiming it first.
+func (r *Runner) claim(rec *repository.Record, now time.Time) error {
+	rec.Owner = r.ID
+	if r.LeaseDuration > 0 {
+		rec.LeaseExpiresAt = now.Add(r.LeaseDuration)
+	}
+	if err := r.Repository.Update(rec.ID, *rec); err != nil {
+		return err
+	}
+	rec.Version++
+	return nil
+}
+
+// resume finishes a task whose previous run stopped before it completed. A task with a
+// rerun function in Reruns is run again, noting rerunMessage in its status messages if
+// it had started, or started if it had not; all others are aborted with the reason.
+// A task that was asked to be cancelled is never run again.
+func (r *Runner) resume(logger logr.Logger, rec repository.Record, reason, rerunMessage string) error {
+	if rec.CancelRequested {
+		logger.Info("aborting orphaned task that was cancelled")
+		return r.abort(rec, "it was cancelled")
+	}
+	if rec.Rerun != nil && (!rec.Rerun.UntilStarted || rec.StartedAt.IsZero()) {
+		if rebuild, ok := r.Reruns[rec.Rerun.Kind]; ok {
+			request, err := r.openRerun(rec.ID, rec.Rerun)
+			var action func(context.Context, chan repository.StatusMessage) (task.Result, error)
+			if err == nil {
+				action, err = rebuild(logger, request)
+			}
+			if err == nil {
+				logger.Info("running orphaned task again")
+				if !rec.StartedAt.IsZero() {
+					action = rerunAction(action, rerunMessage)
+				}
+				r.start(logger, rec.Description, rec.ID, action, task.Options{
+					Host:           rec.Host,
+					IdempotencyKey: rec.IdempotencyKey,
+					Rerun:          rec.Rerun,
+					CallbackURL:    rec.CallbackURL,
+					RequestID:      rec.RequestID,
+					TraceID:        rec.TraceID,
+					NotBefore:      rec.NotBefore,
+				}, rec.Version+1)
+				return nil
+			}
+			logger.Error(err, "unable to rebuild orphaned task, aborting it")
+		}
+	}
+	logger.Info("aborting orphaned task")
+	return r.abort(rec, reason)
+}
+
+// startedRerun returns the Rerun a task keeps once it started, which is nil for
+// tasks only started again if they had not started.
+func startedRerun(rerun *repository.Rerun) *repository.Rerun {
+	if rerun != nil && rerun.UntilStarted {
+		return nil
+	}
+	return rerun
+}
+
+// rerunAction notes in the task's messages that it is being run again.
+func rerunAction(action func(context.Context, chan repository.StatusMessage) (task.Result, error), message string) func(context.Context, chan repository.StatusMessage) (task.Result, error) {
+	noted := false
+	return func(ctx context.Context, s chan repository.StatusMessage) (task.Result, error) {
+		if !noted {
+			noted = true
+			s <- repository.NewStatusMessage(repository.LevelWarning, "", message)
+		}
+		return action(ctx, s)
+	}
+}
+
+// abort records an orphaned task as failed.
+func (r *Runner) abort(rec repository.Record, reason string) error {
+	now := time.Now().UTC()
+	msg := "task aborted: " + reason
+	rec.State = "complete"
+	rec.Complete = true
+	rec.Result = "action failed"
+	rec.FinishedAt = now
+	rec.Rerun = nil
+	rec.AddMessage(repository.StatusMessage{Time: now, Level: repository.LevelError, Text: msg})
+	rec.Error = &repository.Error{
+		Code:    v1.Code_value["ABORTED"],
+		Message: msg,
+	}
+	if err := r.update(&rec); err != nil {
+		if errors.Is(err, errSuperseded) {
+			return nil
+		}
+		return errors.Wrapf(err, "unable to abort task %v", rec.ID)
+	}
+	r.emitFinal(rec)
+	return nil
+}
+
+// emitFinal sends the event of a completed task.
+func (r *Runner) emitFinal(record repository.Record) {
+	if record.Failed() {
+		r.emit(EventFailed, record, nil)
+		return
+	}
+	r.emit(EventCompleted, record, nil)
+}
+
+// run executes the action in a worker slot, persisting its status messages as they arrive.
+func (r *Runner) run(ctx context.Context, logger logr.Logger, action func(context.Context, chan repository.StatusMessage) (task.Result, error), o task.Options, sessionRecord *repository.Record) (task.Result, error) {
+	r.counterMu.Lock()
+	r.active++
+	r.total++
+	r.counterMu.Unlock()
+	defer func() {
+		r.counterMu.Lock()
+		r.active--
+		r.counterMu.Unlock()
+	}()
+
+	metrics.TasksTotal.Inc()
+	metrics.TasksActive.Inc()
+	defer metrics.TasksActive.Dec()
+
+	messagesChan := make(chan repository.StatusMessage, messageBuffer)
+	attemptsChan := make(chan int)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		r.persistMessages(logger, messagesChan, attemptsChan, sessionRecord)
+	}()
+
+	policy := r.RetryPolicy
+	if o.RetryPolicy != nil {
+		policy = policy.Override(*o.RetryPolicy)
+	}
+	result, err := r.attempt(ctx, logger, policy, messagesChan, attemptsChan, action)
+	close(messagesChan)
+	close(attemptsChan)
+	<-done
+	return result, err
+}
+
+// persistMessages adds the status messages and attempt numbers of a running task to
+// its record until both channels are closed. It blocks on the channels rather than
+// polling them. Messages that arrive while the record is being written are written
+// together by the next update, so a slow repository slows down the action only once
+// messageBuffer messages are waiting. Messages are added in the order they were sent,
+// and the record holds every message, even after a failed update, so the final update
+// of the task persists all of them.
+func (r *Runner) persistMessages(logger logr.Logger, messages <-chan repository.StatusMessage, attempts <-chan int, record *repository.Record) {
+	var batch []repository.StatusMessage
+	for messages != nil || attempts != nil {
+		select {
+		case msg, ok := <-messages:
+			if !ok {
+				messages = nil
+				continue
+			}
+			batch = append(batch[:0], msg)
+		drain:
+			for {
+				select {
+				case msg, ok := <-messages:
+					if !ok {
+						messages = nil
+						break drain
+					}
+					batch = append(batch, msg)
+				default:
+					break drain
+				}
+			}
+			for _, msg := range batch {
+				record.AddMessage(msg)
+			}
+			if err := r.update(record); err != nil {
+				logger.Error(err, "unable to persist status messages", "count", len(batch))
+			}
+			r.publish(*record, false)
+			for i := range batch {
+				r.emit(EventMessage, *record, &batch[i])
+			}
+		case n, ok := <-attempts:
+			if !ok {
+				attempts = nil
+				continue
+			}
+			record.Attempts = n
+			if err := r.update(record); err != nil {
+				logger.Error(err, "unable to persist attempt", "attempt", n)
+			}
+			r.publish(*record, false)
+		}
+	}
+}
+
+// update writes the record of a task run by this Runner. Updates are compare-and-swap,
+// so a write based on a stale record fails with repository.ErrConflict. The Runner owns
+// the progress of its tasks, so on a conflict it reads the stored record, carries its
+// progress over to it and tries again, keeping the changes of the other writer.
+// It gives up with errSuperseded, leaving record as it was, when the stored record
+// was completed or taken over by another owner in the meantime.
+func (r *Runner) update(record *repository.Record) error {
+	for attempt := 1; ; attempt++ {
+		err := r.Repository.Update(record.ID, *record)
+		if err == nil {
+			record.Version++
+			return nil
+		}
+		if !errors.Is(err, repository.ErrConflict) || attempt >= maxUpdateAttempts {
+			return err
+		}
+		metrics.TaskUpdateConflicts.Inc()
+		stored, gerr := r.Repository.Get(record.ID)
+		if gerr != nil {
+			return multierror.Append(err, gerr)
+		}
+		if stored.Complete || stored.Owner != record.Owner {
+			return errSuperseded
+		}
+		*record = withProgress(stored, *record)
+	}
+}
+
+// withProgress returns stored with the fields the owner of a task writes as it runs taken from progress.
+func withProgress(stored, progress repository.Record) repository.Record {
+	stored.State = progress.State
+	stored.Result = progress.Result
+	stored.TypedResult = progress.TypedResult
+	stored.Error = progress.Error
+	stored.Complete = progress.Complete
+	stored.Messages = progress.Messages
+	stored.StatusMessages = progress.StatusMessages
+	stored.StartedAt = progress.StartedAt
+	stored.FinishedAt = progress.FinishedAt
+	stored.Attempts = progress.Attempts
+	stored.Rerun = progress.Rerun
+	return stored
+}
+
+// reservation is a task's claim on a worker slot and, when the task's host
+// is limited by MaxWorkersPerHost, on one of the host's slots.
+type reservation struct {
+	taskID string
+	// host is set when the task needs a slot of the host.
+	host      string
+	hostReady chan struct{}
+	// ready is nil until the task holds its host slot and queues for a worker slot.
+	ready  chan struct{}
+	queued bool
+	// blocking are the IDs of the tasks holding the host's slots when the task was queued for one.
+	blocking []string
+}
+
+// message describes what a queued task is waiting for.
+func (res *reservation) message() string {
+	if len(res.blocking) > 0 {
+		return fmt.Sprintf("queued behind task %v on host %v", strings.Join(res.blocking, ", "), res.host)
+	}
+	return "queued for a free worker"
+}
+
+// reserve claims the slots a task needs, queueing it for the first one that is not free.
+// Tasks share the slots of a host whichever way its address is written, see repository.HostKey.
+func (r *Runner) reserve(taskID, host string) *reservation {
+	r.poolMu.Lock()
+	defer r.poolMu.Unlock()
+	res := &reservation{taskID: taskID}
+	host = repository.HostKey(host)
+	if host != "" && r.MaxWorkersPerHost > 0 {
+		if r.hosts == nil {
+			r.hosts = make(map[string]*pool)
+		}
+		hp, ok := r.hosts[host]
+		if !ok {
+			hp = &pool{limit: r.MaxWorkersPerHost}
+			r.hosts[host] = hp
+		}
+		res.host = host
+		blocking := append([]string(nil), hp.holders...)
+		var queued bool
+		if res.hostReady, queued = hp.acquire(taskID); queued {
+			res.queued = true
+			res.blocking = blocking
+			return res
+		}
+	}
+	r.workers.limit = r.MaxWorkers
+	res.ready, res.queued = r.workers.acquire(taskID)
+	return res
+}
+
+// waitUntil blocks a scheduled task until t or until ctx is done.
+func (r *Runner) waitUntil(ctx context.Context, t time.Time) error {
+	metrics.TasksScheduled.Inc()
+	defer metrics.TasksScheduled.Dec()
+	timer := time.NewTimer(time.Until(t))
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// wait blocks a queued task until it holds the slots it reserved or ctx is done.
+func (r *Runner) wait(ctx context.Context, res *reservation) error {
+	start := time.Now()
+	metrics.TasksQueued.Inc()
+	defer func() {
+		metrics.TasksQueued.Dec()
+		metrics.TaskQueueWait.Observe(time.Since(start).Seconds())
+	}()
+	if res.ready == nil {
+		select {
+		case <-res.hostReady:
+		case <-ctx.Done():
+			r.unreserve(res)
+			return ctx.Err()
+		}
+		r.poolMu.Lock()
+		r.workers.limit = r.MaxWorkers
+		res.ready, _ = r.workers.acquire(res.taskID)
+		r.poolMu.Unlock()
+	}
+	select {
+	case <-res.ready:
+		return nil
+	case <-ctx.Done():
+		r.unreserve(res)
+		return ctx.Err()
+	}
+}
+
+// unreserve gives up the slots a task holds or is queued for.
+func (r *Runner) unreserve(res *reservation) {
+	r.poolMu.Lock()
+	defer r.poolMu.Unlock()
+	if res.ready != nil && !r.workers.dequeue(res.taskID) {
+		r.workers.release(res.taskID)
+	}
+	if hp, ok := r.hosts[res.host]; ok && res.host != "" {
+		if !hp.dequeue(res.taskID) {
+			hp.release(res.taskID)
+		}
+		if hp.idle() {
+			delete(r.hosts, res.host)
+		}
+	}
+}
+
+// attempt runs the action until it succeeds, fails with a non-retryable error,
+// runs out of attempts or ctx is cancelled. Each attempt is noted in the status messages
+// and its number sent on attemptsChan.
+func (r *Runner) attempt(ctx context.Context, logger logr.Logger, policy task.RetryPolicy, messages chan repository.StatusMessage, attemptsChan chan int, action func(context.Context, chan repository.StatusMessage) (task.Result, error)) (result task.Result, err error) {
+	attempts := policy.Attempts()
+	for attempt := 1; ; attempt++ {
+		attemptsChan <- attempt
+		if attempts > 1 {
+			messages <- repository.NewStatusMessage(repository.LevelInfo, "", fmt.Sprintf("attempt %d of %d", attempt, attempts))
+		}
+		result, err = action(ctx, messages)
+		if err == nil || attempt >= attempts || ctx.Err() != nil || !policy.Retryable(err) {
+			return result, err
+		}
+		wait := policy.Backoff(attempt)
+		logger.Info("attempt failed, retrying", "attempt", attempt, "maxAttempts", attempts, "backoff", wait.String(), "error", err.Error())
+		messages <- repository.NewStatusMessage(repository.LevelWarning, "", fmt.Sprintf("attempt %d of %d failed: %v; retrying in %v", attempt, attempts, err, wait))
+		select {
+		case <-ctx.Done():
+			return result, err
+		case <-time.After(wait):
+		}
+	}
+}
+
 // Status returns the status record of a task.
 func (r *Runner) Status(_ context.Context, taskID string) (record repository.Record, err error) {
 	record, err = r.Repository.Get(taskID)
@@ -157,3 +1123,85 @@ func (r *Runner) Status(_ context.Context, taskID string) (record repository.Rec
 	}
 	return
 }
+
+// List returns the task records that match the filter, newest first.
+func (r *Runner) List(_ context.Context, filter repository.Filter) ([]repository.Record, error) {
+	records, err := r.Repository.List(filter)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(records, func(i, j int) bool {
+		if !records[i].CreatedAt.Equal(records[j].CreatedAt) {
+			return records[i].CreatedAt.After(records[j].CreatedAt)
+		}
+		return records[i].ID > records[j].ID
+	})
+	return records, nil
+}
+
+// Reap runs the record reaper every interval until ctx is cancelled.
+// It is a no-op when neither Retention nor FailedRetention is set, or interval is not positive.
+func (r *Runner) Reap(ctx context.Context, logger logr.Logger, interval time.Duration) {
+	if interval <= 0 || (r.Retention <= 0 && r.FailedRetention <= 0) {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		n, err := r.reapExpired(time.Now())
+		if err != nil {
+			logger.Error(err, "failed to reap expired task records")
+		} else if n > 0 {
+			logger.V(1).Info("reaped expired task records", "count", n)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// reapExpired deletes completed task records whose retention has passed as of now.
+// It returns the number of records it deleted, which leaves out records that another
+// Runner sharing the repository deleted first.
+func (r *Runner) reapExpired(now time.Time) (int, error) {
+	complete := true
+	records, err := r.Repository.List(repository.Filter{Complete: &complete})
+	if err != nil {
+		return 0, err
+	}
+	var reaped int
+	var errs error
+	for _, rec := range records {
+		if !r.expired(rec, now) {
+			continue
+		}
+		if err := r.Repository.Delete(rec.ID); err != nil {
+			// a record another Runner reaped first is not counted here.
+			if !errors.Is(err, repository.ErrNotFound) {
+				errs = multierror.Append(errs, err)
+			}
+			continue
+		}
+		if rec.IdempotencyKey != "" {
+			if err := r.Repository.ReleaseKey(idempotencyScope(rec.Description, rec.Host, rec.IdempotencyKey), rec.ID); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+		}
+		reaped++
+		metrics.TasksReaped.Inc()
+	}
+	return reaped, errs
+}
+
+func (r *Runner) expired(rec repository.Record, now time.Time) bool {
+	if !rec.Complete || rec.FinishedAt.IsZero() {
+		return false
+	}
+	retention := r.Retention
+	if rec.Failed() {
+		retention = r.FailedRetention
+	}
+	return retention > 0 && now.Sub(rec.FinishedAt) > retention
+}
@@ -2,6 +2,11 @@ package taskrunner
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -9,8 +14,11 @@ import (
 	"github.com/philippgille/gokv"
 	"github.com/philippgille/gokv/freecache"
 	"github.com/rs/xid"
+	v1 "github.com/tinkerbell/pbnj/api/v1"
 	"github.com/tinkerbell/pbnj/grpc/persistence"
 	"github.com/tinkerbell/pbnj/pkg/repository"
+	"github.com/tinkerbell/pbnj/pkg/task"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestRoundTrip(t *testing.T) {
@@ -32,8 +40,8 @@ func TestRoundTrip(t *testing.T) {
 	}
 
 	taskID := xid.New().String()
-	runner.Execute(ctx, logger, description, taskID, func(_ chan string) (string, error) {
-		return "didnt do anything", defaultError
+	runner.Execute(ctx, logger, description, taskID, func(_ context.Context, _ chan repository.StatusMessage) (task.Result, error) {
+		return task.Result{Text: "didnt do anything"}, defaultError
 	})
 
 	if len(taskID) != 20 {
@@ -51,3 +59,1306 @@ func TestRoundTrip(t *testing.T) {
 		t.Fatalf("expected task to be complete, got: %+v", record)
 	}
 }
+
+func TestReapExpired(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	defer s.Close()
+	repo := &persistence.GoKV{Store: s, Ctx: ctx}
+	runner := Runner{
+		Repository:      repo,
+		Ctx:             ctx,
+		Retention:       time.Hour,
+		FailedRetention: 24 * time.Hour,
+	}
+
+	now := time.Now()
+	records := []repository.Record{
+		{ID: "running", State: "running", CreatedAt: now.Add(-48 * time.Hour)},
+		{ID: "complete-fresh", State: "complete", Complete: true, FinishedAt: now.Add(-time.Minute)},
+		{ID: "complete-expired", State: "complete", Complete: true, FinishedAt: now.Add(-2 * time.Hour), Description: "test task", IdempotencyKey: "abc"},
+		{ID: "failed-fresh", State: "complete", Complete: true, FinishedAt: now.Add(-2 * time.Hour), Error: &repository.Error{Message: "boom"}},
+		{ID: "failed-expired", State: "complete", Complete: true, FinishedAt: now.Add(-25 * time.Hour), Error: &repository.Error{Message: "boom"}},
+	}
+	for _, rec := range records {
+		if err := repo.Create(rec.ID, rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	scope := idempotencyScope("test task", "", "abc")
+	if _, err := repo.ClaimKey(scope, "complete-expired", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	reaped, err := runner.reapExpired(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reaped != 2 {
+		t.Fatalf("expected 2 records reaped, got: %v", reaped)
+	}
+	for _, id := range []string{"running", "complete-fresh", "failed-fresh"} {
+		if _, err := repo.Get(id); err != nil {
+			t.Fatalf("expected record %v to be kept: %v", id, err)
+		}
+	}
+	for _, id := range []string{"complete-expired", "failed-expired"} {
+		if _, err := repo.Get(id); err == nil {
+			t.Fatalf("expected record %v to be reaped", id)
+		}
+	}
+	// the idempotency key of a reaped task starts a new task.
+	if id, err := repo.ClaimKey(scope, "new", 0); err != nil || id != "new" {
+		t.Fatalf("expected the key of the reaped task to be released, got: %v, %v", id, err)
+	}
+}
+
+func TestReapExpiredReplicas(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	defer s.Close()
+	repo := &persistence.GoKV{Store: s, Ctx: ctx}
+	now := time.Now()
+	for i := 0; i < 20; i++ {
+		id := fmt.Sprintf("expired-%v", i)
+		if err := repo.Create(id, repository.Record{ID: id, State: "complete", Complete: true, FinishedAt: now.Add(-2 * time.Hour)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// replicas sharing the repository reap the same records, each is counted once.
+	var total int32
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			runner := Runner{Repository: repo, Ctx: ctx, Retention: time.Hour}
+			reaped, err := runner.reapExpired(now)
+			if err != nil {
+				t.Error(err)
+			}
+			atomic.AddInt32(&total, int32(reaped))
+		}()
+	}
+	wg.Wait()
+	if total != 20 {
+		t.Fatalf("expected 20 records reaped in all, got: %v", total)
+	}
+}
+
+func TestCancel(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	defer s.Close()
+	repo := &persistence.GoKV{Store: s, Ctx: ctx}
+	runner := Runner{
+		Repository: repo,
+		Ctx:        ctx,
+	}
+
+	started := make(chan struct{})
+	taskID := xid.New().String()
+	runner.Execute(ctx, logr.Discard(), "test task", taskID, func(ctx context.Context, _ chan repository.StatusMessage) (task.Result, error) {
+		close(started)
+		<-ctx.Done()
+		return task.Result{}, ctx.Err()
+	})
+	<-started
+
+	if err := runner.Cancel(ctx, taskID); err != nil {
+		t.Fatal(err)
+	}
+
+	var record repository.Record
+	for i := 0; i < 50; i++ {
+		var err error
+		record, err = runner.Status(ctx, taskID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if record.Complete {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if !record.Complete || record.State != "cancelled" {
+		t.Fatalf("expected task to be cancelled, got: %+v", record)
+	}
+	if record.Error.Code != v1.Code_value["CANCELLED"] {
+		t.Fatalf("expected error code CANCELLED, got: %v", record.Error.Code)
+	}
+
+	if err := runner.Cancel(ctx, taskID); !errors.Is(err, task.ErrNotRunning) {
+		t.Fatalf("expected %v, got: %v", task.ErrNotRunning, err)
+	}
+	if err := runner.Cancel(ctx, "unknown"); err == nil {
+		t.Fatal("expected error cancelling unknown task")
+	}
+}
+
+func TestCancelOtherRunner(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	defer s.Close()
+	repo := &persistence.GoKV{Store: s, Ctx: ctx}
+	owner := &Runner{Repository: repo, Ctx: ctx, ID: "pbnj-0", LeaseDuration: time.Minute}
+	other := &Runner{Repository: repo, Ctx: ctx, ID: "pbnj-1", LeaseDuration: time.Minute}
+
+	started := make(chan struct{})
+	taskID := xid.New().String()
+	owner.Execute(ctx, logr.Discard(), "test task", taskID, func(ctx context.Context, _ chan repository.StatusMessage) (task.Result, error) {
+		close(started)
+		<-ctx.Done()
+		return task.Result{}, ctx.Err()
+	})
+	<-started
+	waitForRecord(t, owner, taskID, func(r repository.Record) bool { return r.State == "running" })
+
+	if err := other.Cancel(ctx, taskID); err != nil {
+		t.Fatal(err)
+	}
+	rec, err := other.Status(ctx, taskID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rec.CancelRequested || rec.Complete {
+		t.Fatalf("expected the cancel to be requested of the owner, got: %+v", rec)
+	}
+
+	// the owner cancels the task when it renews its lease.
+	owner.renewLeases(logr.Discard(), time.Now().UTC())
+	rec = waitForRecord(t, owner, taskID, func(r repository.Record) bool { return r.Complete })
+	if rec.State != "cancelled" || rec.Error.Code != v1.Code_value["CANCELLED"] {
+		t.Fatalf("expected the task to be cancelled, got: %+v", rec)
+	}
+	if err := other.Cancel(ctx, taskID); !errors.Is(err, task.ErrNotRunning) {
+		t.Fatalf("expected %v, got: %v", task.ErrNotRunning, err)
+	}
+}
+
+func TestCancelOtherRunnerWithoutLeases(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	defer s.Close()
+	repo := &persistence.GoKV{Store: s, Ctx: ctx}
+	if err := repo.Create("running", repository.Record{ID: "running", State: "running", Owner: "pbnj-0"}); err != nil {
+		t.Fatal(err)
+	}
+	runner := Runner{Repository: repo, Ctx: ctx, ID: "pbnj-1"}
+
+	err := runner.Cancel(ctx, "running")
+	if !errors.Is(err, task.ErrRunningElsewhere) || !strings.Contains(err.Error(), "pbnj-0") {
+		t.Fatalf("expected %v naming the owner, got: %v", task.ErrRunningElsewhere, err)
+	}
+}
+
+func TestScheduled(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	defer s.Close()
+	repo := &persistence.GoKV{Store: s, Ctx: ctx}
+	runner := Runner{
+		Repository: repo,
+		Ctx:        ctx,
+		MaxWorkers: 1,
+	}
+	// waitFor returns the task's record once cond holds for it.
+	waitFor := func(taskID string, cond func(repository.Record) bool) repository.Record {
+		t.Helper()
+		for i := 0; i < 200; i++ {
+			if record, err := runner.Status(ctx, taskID); err == nil && cond(record) {
+				return record
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatalf("timed out waiting for task %v", taskID)
+		return repository.Record{}
+	}
+
+	var ran atomic.Int32
+	action := func(_ context.Context, _ chan repository.StatusMessage) (task.Result, error) {
+		ran.Add(1)
+		return task.Result{Text: "done"}, nil
+	}
+
+	notBefore := time.Now().UTC().Add(300 * time.Millisecond)
+	dueID := xid.New().String()
+	runner.Execute(ctx, logr.Discard(), "test task", dueID, action, task.WithNotBefore(notBefore))
+	laterID := xid.New().String()
+	runner.Execute(ctx, logr.Discard(), "test task", laterID, action, task.WithNotBefore(time.Now().Add(time.Hour)))
+
+	record := waitFor(dueID, func(r repository.Record) bool { return r.ID != "" })
+	if record.State != "scheduled" || !record.StartedAt.IsZero() || !record.NotBefore.Equal(notBefore) {
+		t.Fatalf("expected task to be scheduled, got: %+v", record)
+	}
+	waitFor(laterID, func(r repository.Record) bool { return r.ID != "" })
+	scheduled, err := runner.List(ctx, repository.Filter{States: []string{"scheduled"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(scheduled) != 2 {
+		t.Fatalf("expected 2 scheduled tasks, got: %v", len(scheduled))
+	}
+	if runner.QueuedTasks() != 0 {
+		t.Fatalf("expected scheduled tasks not to hold or wait for worker slots, got %v queued", runner.QueuedTasks())
+	}
+
+	record = waitFor(dueID, func(r repository.Record) bool { return r.Complete })
+	if record.State != "complete" || record.StartedAt.Before(notBefore) {
+		t.Fatalf("expected task to run once due, got: %+v", record)
+	}
+
+	if err := runner.Cancel(ctx, laterID); err != nil {
+		t.Fatal(err)
+	}
+	record = waitFor(laterID, func(r repository.Record) bool { return r.Complete })
+	if record.State != "cancelled" || record.Error.Code != v1.Code_value["CANCELLED"] {
+		t.Fatalf("expected task to be cancelled, got: %+v", record)
+	}
+	if n := ran.Load(); n != 1 {
+		t.Fatalf("expected only the due task to run, got %v runs", n)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	defer s.Close()
+	repo := &persistence.GoKV{Store: s, Ctx: ctx}
+	runner := Runner{
+		Repository: repo,
+		Ctx:        ctx,
+		RetryPolicy: task.RetryPolicy{
+			MaxAttempts:    1,
+			InitialBackoff: time.Millisecond,
+			RetryableCodes: []int32{v1.Code_value["UNKNOWN"]},
+		},
+	}
+
+	tests := map[string]struct {
+		failures         int
+		code             int32
+		expectedAttempts int
+		expectedComplete bool
+		expectedFailed   bool
+	}{
+		"succeeds after retries":   {failures: 2, code: v1.Code_value["UNKNOWN"], expectedAttempts: 3},
+		"runs out of attempts":     {failures: 5, code: v1.Code_value["UNKNOWN"], expectedAttempts: 3, expectedFailed: true},
+		"non-retryable error code": {failures: 5, code: v1.Code_value["INVALID_ARGUMENT"], expectedAttempts: 1, expectedFailed: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var attempts int
+			taskID := xid.New().String()
+			runner.Execute(ctx, logr.Discard(), "test task", taskID, func(_ context.Context, _ chan repository.StatusMessage) (task.Result, error) {
+				attempts++
+				if attempts <= tc.failures {
+					return task.Result{}, &repository.Error{Code: tc.code, Message: "failed"}
+				}
+				return task.Result{Text: "done"}, nil
+			}, task.WithRetryPolicy(task.RetryPolicy{MaxAttempts: 3}))
+
+			var record repository.Record
+			for i := 0; i < 100 && !record.Complete; i++ {
+				time.Sleep(10 * time.Millisecond)
+				record, _ = runner.Status(ctx, taskID)
+			}
+			if !record.Complete {
+				t.Fatalf("expected task to be complete, got: %+v", record)
+			}
+			if attempts != tc.expectedAttempts {
+				t.Fatalf("expected %v attempts, got: %v", tc.expectedAttempts, attempts)
+			}
+			if record.Attempts != tc.expectedAttempts {
+				t.Fatalf("expected %v attempts to be recorded, got: %v", tc.expectedAttempts, record.Attempts)
+			}
+			if record.StartedAt.IsZero() || record.FinishedAt.Before(record.StartedAt) || record.StartedAt.Before(record.CreatedAt) {
+				t.Fatalf("expected created <= started <= finished, got: %+v", record)
+			}
+			if record.Failed() != tc.expectedFailed {
+				t.Fatalf("expected failed=%v, got: %+v", tc.expectedFailed, record)
+			}
+			if record.Messages[0] != "attempt 1 of 3" {
+				t.Fatalf("expected attempts to be recorded in messages, got: %v", record.Messages)
+			}
+		})
+	}
+}
+
+func TestWatch(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	defer s.Close()
+	repo := &persistence.GoKV{Store: s, Ctx: ctx}
+	runner := Runner{
+		Repository: repo,
+		Ctx:        ctx,
+	}
+
+	release := make(chan struct{})
+	taskID := xid.New().String()
+	runner.Execute(ctx, logr.Discard(), "test task", taskID, func(_ context.Context, msgs chan repository.StatusMessage) (task.Result, error) {
+		msgs <- repository.NewStatusMessage(repository.LevelInfo, "ipmitool", "working")
+		<-release
+		return task.Result{Text: "done"}, nil
+	})
+
+	records, err := runner.Watch(ctx, taskID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	close(release)
+	var last repository.Record
+	for record := range records {
+		last = record
+	}
+	if !last.Complete || last.Result != "done" {
+		t.Fatalf("expected final record, got: %+v", last)
+	}
+	if len(last.Messages) != 1 || last.Messages[0] != "working" {
+		t.Fatalf("expected the task's messages, got: %v", last.Messages)
+	}
+
+	// watching a completed task sends its final record.
+	records, err = runner.Watch(ctx, taskID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	last = <-records
+	if !last.Complete {
+		t.Fatalf("expected final record, got: %+v", last)
+	}
+	if _, ok := <-records; ok {
+		t.Fatal("expected channel to be closed")
+	}
+
+	if _, err := runner.Watch(ctx, "123"); err == nil {
+		t.Fatal("expected error watching unknown task")
+	}
+}
+
+// slowGetRepository reads records as they are when Get is called, but returns them
+// only once release is closed, while held is set.
+type slowGetRepository struct {
+	repository.Actions
+	held    atomic.Bool
+	reading chan struct{}
+	release chan struct{}
+}
+
+func (s *slowGetRepository) Get(id string) (repository.Record, error) {
+	rec, err := s.Actions.Get(id)
+	if s.held.Load() {
+		close(s.reading)
+		<-s.release
+	}
+	return rec, err
+}
+
+func TestWatchSlowStore(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	defer s.Close()
+	inner := &persistence.GoKV{Store: s, Ctx: ctx}
+	repo := &slowGetRepository{Actions: inner, reading: make(chan struct{}), release: make(chan struct{})}
+	runner := Runner{
+		Repository: repo,
+		Ctx:        ctx,
+	}
+
+	working := make(chan struct{})
+	release := make(chan struct{})
+	taskID := xid.New().String()
+	runner.Execute(ctx, logr.Discard(), "test task", taskID, func(_ context.Context, msgs chan repository.StatusMessage) (task.Result, error) {
+		<-working
+		msgs <- repository.NewStatusMessage(repository.LevelInfo, "ipmitool", "working")
+		<-release
+		return task.Result{Text: "done"}, nil
+	})
+	waitForRecord(t, &runner, taskID, func(r repository.Record) bool { return r.State == "running" })
+
+	repo.held.Store(true)
+	watched := make(chan (<-chan repository.Record))
+	go func() {
+		records, err := runner.Watch(ctx, taskID)
+		if err != nil {
+			t.Error(err)
+		}
+		watched <- records
+	}()
+	<-repo.reading
+	repo.held.Store(false)
+
+	// the task keeps publishing its records while Watch waits for the store.
+	close(working)
+	for start := time.Now(); ; time.Sleep(5 * time.Millisecond) {
+		if rec, _ := inner.Get(taskID); len(rec.Messages) == 1 {
+			break
+		}
+		if time.Since(start) > time.Second {
+			t.Fatal("timed out waiting for the task's message to be written")
+		}
+	}
+	close(repo.release)
+
+	// the record published since Watch read the store is sent rather than the stale one.
+	records := <-watched
+	if first := <-records; len(first.Messages) != 1 {
+		t.Fatalf("expected the newest record first, got: %+v", first)
+	}
+	close(release)
+	var last repository.Record
+	for record := range records {
+		last = record
+	}
+	if !last.Complete || last.Result != "done" {
+		t.Fatalf("expected final record, got: %+v", last)
+	}
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	defer s.Close()
+	repo := &persistence.GoKV{Store: s, Ctx: ctx}
+	runner := Runner{
+		Repository: repo,
+		Ctx:        ctx,
+	}
+
+	var runs int32
+	release := make(chan struct{})
+	action := func(_ context.Context, _ chan repository.StatusMessage) (task.Result, error) {
+		atomic.AddInt32(&runs, 1)
+		<-release
+		return task.Result{Text: "done"}, nil
+	}
+	firstID := xid.New().String()
+	if id := runner.Execute(ctx, logr.Discard(), "test task", firstID, action, task.WithIdempotencyKey("abc")); id != firstID {
+		t.Fatalf("expected task %v to start, got: %v", firstID, id)
+	}
+	// a repeat while the first task runs, before or after its record is written.
+	if id := runner.Execute(ctx, logr.Discard(), "test task", xid.New().String(), action, task.WithIdempotencyKey("abc")); id != firstID {
+		t.Fatalf("expected the first task %v, got: %v", firstID, id)
+	}
+	close(release)
+	waitForRecord(t, &runner, firstID, func(r repository.Record) bool { return r.Complete })
+	// a repeat after the first task completed.
+	if id := runner.Execute(ctx, logr.Discard(), "test task", xid.New().String(), action, task.WithIdempotencyKey("abc")); id != firstID {
+		t.Fatalf("expected the first task %v, got: %v", firstID, id)
+	}
+	if n := atomic.LoadInt32(&runs); n != 1 {
+		t.Fatalf("expected the action to run once, got: %v", n)
+	}
+
+	otherID := xid.New().String()
+	if id := runner.Execute(ctx, logr.Discard(), "test task", otherID, action, task.WithIdempotencyKey("def")); id != otherID {
+		t.Fatalf("expected a new task for a different key, got: %v", id)
+	}
+	waitForRecord(t, &runner, otherID, func(r repository.Record) bool { return r.Complete })
+
+	// keys are scoped to the task's description and host.
+	for _, tc := range []struct {
+		description string
+		host        string
+	}{
+		{description: "other task"},
+		{description: "test task", host: "10.1.1.1"},
+	} {
+		scopedID := xid.New().String()
+		if id := runner.Execute(ctx, logr.Discard(), tc.description, scopedID, action, task.WithIdempotencyKey("abc"), task.WithHost(tc.host)); id != scopedID {
+			t.Fatalf("expected a new task for %+v, got: %v", tc, id)
+		}
+		waitForRecord(t, &runner, scopedID, func(r repository.Record) bool { return r.Complete })
+	}
+
+	// keys are only honoured within the window the claim was made with.
+	runner.IdempotencyWindow = 20 * time.Millisecond
+	windowID := xid.New().String()
+	if id := runner.Execute(ctx, logr.Discard(), "test task", windowID, action, task.WithIdempotencyKey("ghi")); id != windowID {
+		t.Fatalf("expected a new task for a different key, got: %v", id)
+	}
+	waitForRecord(t, &runner, windowID, func(r repository.Record) bool { return r.Complete })
+	time.Sleep(50 * time.Millisecond)
+	newID := xid.New().String()
+	if id := runner.Execute(ctx, logr.Discard(), "test task", newID, action, task.WithIdempotencyKey("ghi")); id != newID {
+		t.Fatalf("expected a new task once the window passed, got: %v", id)
+	}
+	waitForRecord(t, &runner, newID, func(r repository.Record) bool { return r.Complete })
+	if n := atomic.LoadInt32(&runs); n != 6 {
+		t.Fatalf("expected the action to run 6 times, got: %v", n)
+	}
+}
+
+func TestIdempotencyKeyReplicas(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	defer s.Close()
+	repo := &persistence.GoKV{Store: s, Ctx: ctx}
+	runners := []*Runner{
+		{Repository: repo, Ctx: ctx, ID: "pbnj-0"},
+		{Repository: repo, Ctx: ctx, ID: "pbnj-1"},
+	}
+
+	var runs int32
+	action := func(_ context.Context, _ chan repository.StatusMessage) (task.Result, error) {
+		atomic.AddInt32(&runs, 1)
+		return task.Result{Text: "done"}, nil
+	}
+	ids := make([]string, 10)
+	var wg sync.WaitGroup
+	for i := range ids {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ids[i] = runners[i%2].Execute(ctx, logr.Discard(), "test task", xid.New().String(), action, task.WithIdempotencyKey("abc"))
+		}(i)
+	}
+	wg.Wait()
+	for _, id := range ids[1:] {
+		if id != ids[0] {
+			t.Fatalf("expected every request to return the same task, got: %v", ids)
+		}
+	}
+	waitForRecord(t, runners[0], ids[0], func(r repository.Record) bool { return r.Complete })
+	if n := atomic.LoadInt32(&runs); n != 1 {
+		t.Fatalf("expected the action to run once, got: %v", n)
+	}
+}
+
+func TestRecover(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	defer s.Close()
+	repo := &persistence.GoKV{Store: s, Ctx: ctx}
+	now := time.Now().UTC()
+	for _, rec := range []repository.Record{
+		{ID: "orphan", Description: "power action: on", State: "running", Owner: "pbnj-0", CreatedAt: now, StartedAt: now},
+		{ID: "queued", Description: "power action: off", State: "queued", Owner: "pbnj-0", CreatedAt: now},
+		{ID: "rerun", Description: "power action: status", State: "running", Owner: "pbnj-0", Host: "10.1.1.1", CreatedAt: now, StartedAt: now,
+			Rerun: &repository.Rerun{Kind: "status", Request: []byte(`"10.1.1.1"`)}},
+		{ID: "other", Description: "power action: on", State: "running", Owner: "pbnj-1", CreatedAt: now, StartedAt: now},
+		{ID: "done", Description: "power action: on", State: "complete", Complete: true, Owner: "pbnj-0", CreatedAt: now, FinishedAt: now},
+	} {
+		if err := repo.Create(rec.ID, rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var rerunRequest string
+	runner := Runner{
+		Repository: repo,
+		Ctx:        ctx,
+		ID:         "pbnj-0",
+		Reruns: map[string]RerunFunc{
+			"status": func(_ logr.Logger, request []byte) (func(context.Context, chan repository.StatusMessage) (task.Result, error), error) {
+				rerunRequest = string(request)
+				return func(_ context.Context, _ chan repository.StatusMessage) (task.Result, error) {
+					return task.Result{Text: "on"}, nil
+				}, nil
+			},
+		},
+	}
+	if err := runner.Recover(logr.Discard()); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range []string{"orphan", "queued"} {
+		rec, err := runner.Status(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !rec.Complete || rec.Error.Code != v1.Code_value["ABORTED"] || rec.FinishedAt.IsZero() {
+			t.Fatalf("expected task %v to be aborted, got: %+v", id, rec)
+		}
+	}
+
+	rec := waitForRecord(t, &runner, "rerun", func(r repository.Record) bool { return r.Complete })
+	if rec.Failed() || rec.Result != "on" || rec.Host != "10.1.1.1" {
+		t.Fatalf("expected task to be run again, got: %+v", rec)
+	}
+	if rec.Rerun != nil {
+		t.Fatalf("expected the rerun request to be cleared, got: %+v", rec.Rerun)
+	}
+	if rerunRequest != `"10.1.1.1"` {
+		t.Fatalf("expected the stored request to be rerun, got: %v", rerunRequest)
+	}
+	if len(rec.Messages) == 0 {
+		t.Fatal("expected a message about the task running again")
+	}
+
+	// tasks of other replicas and completed tasks are left alone.
+	if rec, _ := runner.Status(ctx, "other"); rec.Complete {
+		t.Fatalf("expected the other replica's task to be left alone, got: %+v", rec)
+	}
+	if rec, _ := runner.Status(ctx, "done"); rec.Failed() {
+		t.Fatalf("expected the completed task to be left alone, got: %+v", rec)
+	}
+}
+
+func TestRecoverScheduled(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	defer s.Close()
+	repo := &persistence.GoKV{Store: s, Ctx: ctx}
+	now := time.Now().UTC()
+	notBefore := now.Add(200 * time.Millisecond)
+	key := []byte("0123456789abcdef0123456789abcdef")
+	sealed, err := (&Runner{RequestKey: key}).sealRerun("scheduled", &repository.Rerun{Kind: "reset", Request: []byte(`"cold"`), UntilStarted: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, rec := range []repository.Record{
+		{ID: "scheduled", Description: "bmc reset", State: "scheduled", Owner: "pbnj-0", CreatedAt: now, NotBefore: notBefore, Rerun: sealed},
+		{ID: "started", Description: "bmc reset", State: "running", Owner: "pbnj-0", CreatedAt: now, StartedAt: now,
+			Rerun: &repository.Rerun{Kind: "reset", Request: []byte(`"warm"`), UntilStarted: true}},
+	} {
+		if err := repo.Create(rec.ID, rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var rebuilt []string
+	release := make(chan struct{})
+	runner := Runner{
+		Repository: repo,
+		Ctx:        ctx,
+		ID:         "pbnj-0",
+		RequestKey: key,
+		Reruns: map[string]RerunFunc{
+			"reset": func(_ logr.Logger, request []byte) (func(context.Context, chan repository.StatusMessage) (task.Result, error), error) {
+				rebuilt = append(rebuilt, string(request))
+				return func(_ context.Context, _ chan repository.StatusMessage) (task.Result, error) {
+					<-release
+					return task.Result{Text: "reset"}, nil
+				}, nil
+			},
+		},
+	}
+	if err := runner.Recover(logr.Discard()); err != nil {
+		t.Fatal(err)
+	}
+
+	// a task that had started is not repeated.
+	if rec, _ := runner.Status(ctx, "started"); !rec.Complete || rec.Error.Code != v1.Code_value["ABORTED"] {
+		t.Fatalf("expected the started task to be aborted, got: %+v", rec)
+	}
+	if len(rebuilt) != 1 || rebuilt[0] != `"cold"` {
+		t.Fatalf("expected only the scheduled task to be rebuilt, got: %v", rebuilt)
+	}
+
+	rec := waitForRecord(t, &runner, "scheduled", func(r repository.Record) bool { return r.ID != "" })
+	if rec.State != "scheduled" || !rec.NotBefore.Equal(notBefore) {
+		t.Fatalf("expected the task to keep its schedule, got: %+v", rec)
+	}
+	rec = waitForRecord(t, &runner, "scheduled", func(r repository.Record) bool { return r.State == "running" })
+	if rec.StartedAt.Before(notBefore) {
+		t.Fatalf("expected the task to start once due, got: %+v", rec)
+	}
+	if rec.Rerun != nil {
+		t.Fatalf("expected the stored request to be dropped once the task started, got: %+v", rec.Rerun)
+	}
+	close(release)
+	rec = waitForRecord(t, &runner, "scheduled", func(r repository.Record) bool { return r.Complete })
+	if rec.Failed() || rec.Result != "reset" {
+		t.Fatalf("expected the task to run, got: %+v", rec)
+	}
+}
+
+func TestStoredRequestSealed(t *testing.T) {
+	request := []byte(`{"authn":{"directAuthn":{"password":"secret-password"}}}`)
+	key := []byte("0123456789abcdef0123456789abcdef")
+	tests := map[string]struct {
+		key    []byte
+		sealed bool
+	}{
+		"encrypted with the key":   {key: key, sealed: true},
+		"not stored without a key": {},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			f := freecache.NewStore(freecache.DefaultOptions)
+			s := gokv.Store(f)
+			defer s.Close()
+			repo := &persistence.GoKV{Store: s, Ctx: ctx}
+			runner := &Runner{Repository: repo, Ctx: ctx, RequestKey: tc.key}
+			taskID := xid.New().String()
+			runner.Execute(ctx, logr.Discard(), "bmc reset", taskID, func(_ context.Context, _ chan repository.StatusMessage) (task.Result, error) {
+				return task.Result{}, nil
+			}, task.WithNotBefore(time.Now().Add(time.Hour)), task.WithScheduledRerun("reset", request))
+			defer func() { _ = runner.Cancel(ctx, taskID) }()
+
+			rec := waitForRecord(t, runner, taskID, func(r repository.Record) bool { return r.State == "scheduled" })
+			if !tc.sealed {
+				if rec.Rerun != nil {
+					t.Fatalf("expected no request to be stored, got: %+v", rec.Rerun)
+				}
+				return
+			}
+			if rec.Rerun == nil || len(rec.Rerun.Sealed) == 0 || rec.Rerun.Request != nil || !rec.Rerun.UntilStarted {
+				t.Fatalf("expected only the encrypted request to be stored, got: %+v", rec.Rerun)
+			}
+			if strings.Contains(string(rec.Rerun.Sealed), "secret-password") {
+				t.Fatal("expected the stored request not to hold the password in the clear")
+			}
+
+			opened, err := runner.openRerun(taskID, rec.Rerun)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(opened) != string(request) {
+				t.Fatalf("expected the stored request, got: %s", opened)
+			}
+			if _, err := runner.openRerun("other", rec.Rerun); err == nil {
+				t.Fatal("expected the request not to open for another task")
+			}
+			other := &Runner{RequestKey: []byte("fedcba9876543210fedcba9876543210")}
+			if _, err := other.openRerun(taskID, rec.Rerun); err == nil {
+				t.Fatal("expected the request not to open with another key")
+			}
+		})
+	}
+}
+
+func TestTakeOver(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	defer s.Close()
+	repo := &persistence.GoKV{Store: s, Ctx: ctx}
+	now := time.Now().UTC()
+	expired := now.Add(-time.Second)
+	for _, rec := range []repository.Record{
+		{ID: "expired", Description: "power action: on", State: "running", Owner: "pbnj-1", CreatedAt: now, StartedAt: now, LeaseExpiresAt: expired},
+		{ID: "rerun", Description: "power action: status", State: "running", Owner: "pbnj-1", CreatedAt: now, StartedAt: now, LeaseExpiresAt: expired,
+			Rerun: &repository.Rerun{Kind: "status", Request: []byte(`"10.1.1.1"`)}},
+		{ID: "cancelled", Description: "power action: status", State: "running", Owner: "pbnj-1", CreatedAt: now, StartedAt: now, LeaseExpiresAt: expired,
+			Rerun: &repository.Rerun{Kind: "status", Request: []byte(`"10.1.1.1"`)}, CancelRequested: true},
+		{ID: "leased", Description: "power action: on", State: "running", Owner: "pbnj-1", CreatedAt: now, StartedAt: now, LeaseExpiresAt: now.Add(time.Minute)},
+		{ID: "no-lease", Description: "power action: on", State: "running", Owner: "pbnj-1", CreatedAt: now, StartedAt: now},
+	} {
+		if err := repo.Create(rec.ID, rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	runner := Runner{
+		Repository:    repo,
+		Ctx:           ctx,
+		ID:            "pbnj-0",
+		LeaseDuration: time.Minute,
+		Reruns: map[string]RerunFunc{
+			"status": func(_ logr.Logger, _ []byte) (func(context.Context, chan repository.StatusMessage) (task.Result, error), error) {
+				return func(_ context.Context, _ chan repository.StatusMessage) (task.Result, error) {
+					return task.Result{Text: "on"}, nil
+				}, nil
+			},
+		},
+	}
+	if err := runner.takeOver(logr.Discard(), now); err != nil {
+		t.Fatal(err)
+	}
+
+	rec, err := runner.Status(ctx, "expired")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rec.Complete || rec.Owner != "pbnj-0" || rec.Error.Code != v1.Code_value["ABORTED"] || rec.Error.Message != "task aborted: its owner pbnj-1 stopped renewing its lease" {
+		t.Fatalf("expected the task to be taken over and aborted, got: %+v", rec)
+	}
+
+	rec = waitForRecord(t, &runner, "rerun", func(r repository.Record) bool { return r.Complete })
+	if rec.Failed() || rec.Result != "on" || rec.Owner != "pbnj-0" {
+		t.Fatalf("expected the task to be taken over and run again, got: %+v", rec)
+	}
+	if len(rec.Messages) == 0 || rec.Messages[0] != "task taken over from pbnj-1, running it again" {
+		t.Fatalf("expected a message about the task being taken over, got: %v", rec.Messages)
+	}
+
+	rec = waitForRecord(t, &runner, "cancelled", func(r repository.Record) bool { return r.Complete })
+	if rec.Error.Code != v1.Code_value["ABORTED"] || rec.Error.Message != "task aborted: it was cancelled" {
+		t.Fatalf("expected the cancelled task to be aborted rather than run again, got: %+v", rec)
+	}
+
+	// tasks whose lease has not expired, or that have none, are left alone.
+	for _, id := range []string{"leased", "no-lease"} {
+		if rec, _ := runner.Status(ctx, id); rec.Complete || rec.Owner != "pbnj-1" {
+			t.Fatalf("expected task %v to be left alone, got: %+v", id, rec)
+		}
+	}
+}
+
+func TestLeaseRenewal(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	defer s.Close()
+	repo := &persistence.GoKV{Store: s, Ctx: ctx}
+	runner := &Runner{
+		Repository:    repo,
+		Ctx:           ctx,
+		ID:            "pbnj-0",
+		LeaseDuration: time.Minute,
+	}
+
+	started := make(chan struct{})
+	taskID := xid.New().String()
+	runner.Execute(ctx, logr.Discard(), "test task", taskID, func(ctx context.Context, _ chan repository.StatusMessage) (task.Result, error) {
+		close(started)
+		<-ctx.Done()
+		return task.Result{}, ctx.Err()
+	})
+	<-started
+
+	rec := waitForRecord(t, runner, taskID, func(r repository.Record) bool { return r.State == "running" })
+	if rec.LeaseExpiresAt.IsZero() {
+		t.Fatalf("expected the task to have a lease, got: %+v", rec)
+	}
+	later := time.Now().UTC().Add(time.Minute)
+	runner.renewLeases(logr.Discard(), later)
+	rec, err := runner.Status(ctx, taskID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rec.LeaseExpiresAt.Equal(later.Add(time.Minute)) {
+		t.Fatalf("expected the lease to be renewed to %v, got: %v", later.Add(time.Minute), rec.LeaseExpiresAt)
+	}
+
+	// another replica takes the task over, so it is cancelled here and its record left to the new owner.
+	rec.Owner = "pbnj-1"
+	if err := repo.Update(taskID, rec); err != nil {
+		t.Fatal(err)
+	}
+	runner.renewLeases(logr.Discard(), later)
+	waitForWorker(t, runner, taskID)
+	rec, err = runner.Status(ctx, taskID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Complete || rec.Owner != "pbnj-1" {
+		t.Fatalf("expected the record to be left to the new owner, got: %+v", rec)
+	}
+}
+
+// delayedCreateRepository holds the creation of records until created is closed, and counts the reads of them.
+type delayedCreateRepository struct {
+	repository.Actions
+	created chan struct{}
+	gets    atomic.Int32
+}
+
+func (d *delayedCreateRepository) Create(id string, record repository.Record) error {
+	<-d.created
+	return d.Actions.Create(id, record)
+}
+
+func (d *delayedCreateRepository) Get(id string) (repository.Record, error) {
+	d.gets.Add(1)
+	return d.Actions.Get(id)
+}
+
+func TestLeaseRenewalBeforeCreate(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	defer s.Close()
+	repo := &delayedCreateRepository{Actions: &persistence.GoKV{Store: s, Ctx: ctx}, created: make(chan struct{})}
+	runner := &Runner{
+		Repository:    repo,
+		Ctx:           ctx,
+		ID:            "pbnj-0",
+		LeaseDuration: time.Minute,
+	}
+
+	taskID := xid.New().String()
+	runner.Execute(ctx, logr.Discard(), "test task", taskID, func(ctx context.Context, _ chan repository.StatusMessage) (task.Result, error) {
+		<-ctx.Done()
+		return task.Result{}, ctx.Err()
+	})
+	// the task has no record to renew the lease of yet.
+	runner.renewLeases(logr.Discard(), time.Now().UTC())
+	if n := repo.gets.Load(); n != 0 {
+		t.Fatalf("expected no lease to be renewed before the record is created, got %v reads", n)
+	}
+
+	close(repo.created)
+	waitForRecord(t, runner, taskID, func(r repository.Record) bool { return r.State == "running" })
+	later := time.Now().UTC().Add(time.Minute)
+	runner.renewLeases(logr.Discard(), later)
+	rec, err := runner.Status(ctx, taskID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rec.LeaseExpiresAt.Equal(later.Add(time.Minute)) {
+		t.Fatalf("expected the lease to be renewed to %v, got: %v", later.Add(time.Minute), rec.LeaseExpiresAt)
+	}
+	if err := runner.Cancel(ctx, taskID); err != nil {
+		t.Fatal(err)
+	}
+	waitForWorker(t, runner, taskID)
+}
+
+type sinkFunc func(Event)
+
+func (f sinkFunc) Emit(e Event) { f(e) }
+
+func TestEvents(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	defer s.Close()
+	repo := &persistence.GoKV{Store: s, Ctx: ctx}
+	events := make(chan Event, 10)
+	runner := Runner{
+		Repository: repo,
+		Ctx:        ctx,
+		EventSinks: []EventSink{sinkFunc(func(e Event) { events <- e })},
+	}
+	traceID := trace.TraceID{1, 2, 3}
+	ctx = trace.ContextWithSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: trace.SpanID{1}}))
+
+	next := func() Event {
+		t.Helper()
+		select {
+		case e := <-events:
+			return e
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for event")
+		}
+		return Event{}
+	}
+
+	taskID := xid.New().String()
+	runner.Execute(ctx, logr.Discard(), "test task", taskID, func(_ context.Context, msgs chan repository.StatusMessage) (task.Result, error) {
+		msgs <- repository.NewStatusMessage(repository.LevelInfo, "ipmitool", "working")
+		return task.Result{Text: "done"}, nil
+	}, task.WithCallbackURL("http://localhost/hook"))
+	for _, want := range []string{EventCreated, EventStarted, EventMessage, EventCompleted} {
+		e := next()
+		if e.Type != want || e.Record.ID != taskID || e.Record.TraceID != traceID.String() {
+			t.Fatalf("expected %v event of task %v, got: %+v", want, taskID, e)
+		}
+		if want == EventMessage && (e.Message == nil || e.Message.Text != "working") {
+			t.Fatalf("expected the status message, got: %+v", e.Message)
+		}
+		if want == EventCompleted && (!e.Record.Complete || e.Record.Result != "done" || e.Record.CallbackURL != "http://localhost/hook") {
+			t.Fatalf("expected the final record, got: %+v", e.Record)
+		}
+	}
+
+	failedID := xid.New().String()
+	runner.Execute(ctx, logr.Discard(), "test task", failedID, func(_ context.Context, _ chan repository.StatusMessage) (task.Result, error) {
+		return task.Result{}, errors.New("failed")
+	})
+	for _, want := range []string{EventCreated, EventStarted, EventFailed} {
+		if e := next(); e.Type != want || e.Record.ID != failedID {
+			t.Fatalf("expected %v event of task %v, got: %+v", want, failedID, e)
+		}
+	}
+}
+
+// slowRepository counts the updates of a repository that takes a while to write them.
+type slowRepository struct {
+	repository.Actions
+	updates atomic.Int32
+}
+
+func (s *slowRepository) Update(id string, record repository.Record) error {
+	s.updates.Add(1)
+	time.Sleep(5 * time.Millisecond)
+	return s.Actions.Update(id, record)
+}
+
+func TestStatusMessagesPersisted(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	defer s.Close()
+	repo := &slowRepository{Actions: &persistence.GoKV{Store: s, Ctx: ctx}}
+	done := make(chan Event, 1)
+	runner := Runner{
+		Repository: repo,
+		Ctx:        ctx,
+		EventSinks: []EventSink{sinkFunc(func(e Event) {
+			if e.Type == EventCompleted || e.Type == EventFailed {
+				done <- e
+			}
+		})},
+	}
+
+	const sent = 300
+	taskID := xid.New().String()
+	runner.Execute(ctx, logr.Discard(), "test task", taskID, func(_ context.Context, msgs chan repository.StatusMessage) (task.Result, error) {
+		for i := 0; i < sent; i++ {
+			msgs <- repository.NewStatusMessage(repository.LevelInfo, "", fmt.Sprintf("message %d", i))
+		}
+		return task.Result{Text: "done"}, nil
+	})
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the task to complete")
+	}
+
+	record, err := runner.Status(ctx, taskID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(record.StatusMessages) != sent || len(record.Messages) != sent {
+		t.Fatalf("expected %v messages, got: %v", sent, len(record.StatusMessages))
+	}
+	for i, msg := range record.StatusMessages {
+		if want := fmt.Sprintf("message %d", i); msg.Text != want || record.Messages[i] != want {
+			t.Fatalf("expected message %v to be %q, got: %q", i, want, msg.Text)
+		}
+	}
+	if n := repo.updates.Load(); n >= sent {
+		t.Fatalf("expected status messages to be written in batches, got %v updates for %v messages", n, sent)
+	}
+}
+
+// interferingRepository changes a record, as another writer would, right before the first update of it.
+type interferingRepository struct {
+	repository.Actions
+	once      sync.Once
+	interfere func(*repository.Record)
+}
+
+func (i *interferingRepository) Update(id string, record repository.Record) error {
+	i.once.Do(func() {
+		stored, err := i.Actions.Get(id)
+		if err != nil {
+			panic(err)
+		}
+		i.interfere(&stored)
+		if err := i.Actions.Update(id, stored); err != nil {
+			panic(err)
+		}
+	})
+	return i.Actions.Update(id, record)
+}
+
+// waitForWorker waits until the worker of the task has returned.
+func waitForWorker(t *testing.T, runner *Runner, taskID string) {
+	t.Helper()
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(10 * time.Millisecond) {
+		runner.cancelMu.Lock()
+		_, running := runner.cancels[taskID]
+		runner.cancelMu.Unlock()
+		if !running {
+			return
+		}
+	}
+	t.Fatal("timed out waiting for the task to complete")
+}
+
+func TestUpdateConflict(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	defer s.Close()
+	repo := &interferingRepository{
+		Actions:   &persistence.GoKV{Store: s, Ctx: ctx},
+		interfere: func(r *repository.Record) { r.CallbackURL = "http://changed.example" },
+	}
+	var completed atomic.Int32
+	runner := &Runner{
+		Repository: repo,
+		Ctx:        ctx,
+		EventSinks: []EventSink{sinkFunc(func(e Event) {
+			if e.Type == EventCompleted {
+				completed.Add(1)
+			}
+		})},
+	}
+
+	taskID := xid.New().String()
+	runner.Execute(ctx, logr.Discard(), "test task", taskID, func(_ context.Context, msgs chan repository.StatusMessage) (task.Result, error) {
+		msgs <- repository.NewStatusMessage(repository.LevelInfo, "", "one")
+		msgs <- repository.NewStatusMessage(repository.LevelInfo, "", "two")
+		return task.Result{Text: "done"}, nil
+	})
+	waitForWorker(t, runner, taskID)
+
+	record, err := runner.Status(ctx, taskID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.CallbackURL != "http://changed.example" {
+		t.Fatalf("expected the other writer's change to be kept, got: %+v", record)
+	}
+	if !record.Complete || record.Result != "done" || len(record.Messages) != 2 || record.Attempts != 1 {
+		t.Fatalf("expected the task's progress to be written, got: %+v", record)
+	}
+	if n := completed.Load(); n != 1 {
+		t.Fatalf("expected one completed event, got: %v", n)
+	}
+}
+
+func TestUpdateSuperseded(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	defer s.Close()
+	repo := &interferingRepository{
+		Actions: &persistence.GoKV{Store: s, Ctx: ctx},
+		interfere: func(r *repository.Record) {
+			r.State = "complete"
+			r.Complete = true
+			r.Result = "aborted by another replica"
+		},
+	}
+	var final atomic.Int32
+	runner := &Runner{
+		Repository: repo,
+		Ctx:        ctx,
+		EventSinks: []EventSink{sinkFunc(func(e Event) {
+			if e.Type == EventCompleted || e.Type == EventFailed {
+				final.Add(1)
+			}
+		})},
+	}
+
+	taskID := xid.New().String()
+	runner.Execute(ctx, logr.Discard(), "test task", taskID, func(_ context.Context, _ chan repository.StatusMessage) (task.Result, error) {
+		return task.Result{Text: "done"}, nil
+	})
+	waitForWorker(t, runner, taskID)
+
+	record, err := runner.Status(ctx, taskID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.Result != "aborted by another replica" {
+		t.Fatalf("expected the completed record not to be overwritten, got: %+v", record)
+	}
+	if n := final.Load(); n != 0 {
+		t.Fatalf("expected no final event from the superseded runner, got: %v", n)
+	}
+}
+
+func TestMaxWorkers(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	defer s.Close()
+	repo := &persistence.GoKV{Store: s, Ctx: ctx}
+	runner := Runner{
+		Repository: repo,
+		Ctx:        ctx,
+		MaxWorkers: 1,
+	}
+
+	release := make(chan struct{})
+	var order []string
+	var orderMu sync.Mutex
+	action := func(name string) func(context.Context, chan repository.StatusMessage) (task.Result, error) {
+		return func(_ context.Context, _ chan repository.StatusMessage) (task.Result, error) {
+			orderMu.Lock()
+			order = append(order, name)
+			orderMu.Unlock()
+			<-release
+			return task.Result{Text: name}, nil
+		}
+	}
+	ids := map[string]string{}
+	for _, name := range []string{"first", "second", "cancelled", "third"} {
+		ids[name] = xid.New().String()
+		runner.Execute(ctx, logr.Discard(), name, ids[name], action(name))
+	}
+
+	waitForRecord(t, &runner, ids["first"], func(r repository.Record) bool { return r.State == "running" })
+	for _, name := range []string{"second", "cancelled", "third"} {
+		waitForRecord(t, &runner, ids[name], func(r repository.Record) bool { return r.State == "queued" })
+	}
+	if n := runner.QueuedTasks(); n != 3 {
+		t.Fatalf("expected 3 queued tasks, got: %v", n)
+	}
+
+	if err := runner.Cancel(ctx, ids["cancelled"]); err != nil {
+		t.Fatal(err)
+	}
+	waitForRecord(t, &runner, ids["cancelled"], func(r repository.Record) bool { return r.State == "cancelled" })
+
+	close(release)
+	for _, name := range []string{"first", "second", "third"} {
+		waitForRecord(t, &runner, ids[name], func(r repository.Record) bool { return r.Complete && r.Result == name })
+	}
+	orderMu.Lock()
+	defer orderMu.Unlock()
+	if want := []string{"first", "second", "third"}; len(order) != len(want) || order[0] != want[0] || order[1] != want[1] || order[2] != want[2] {
+		t.Fatalf("expected tasks to run in order %v, got: %v", want, order)
+	}
+	if n := runner.QueuedTasks(); n != 0 {
+		t.Fatalf("expected empty queue, got: %v", n)
+	}
+}
+
+func TestMaxWorkersPerHost(t *testing.T) {
+	ctx := context.Background()
+	f := freecache.NewStore(freecache.DefaultOptions)
+	s := gokv.Store(f)
+	defer s.Close()
+	repo := &persistence.GoKV{Store: s, Ctx: ctx}
+	runner := Runner{
+		Repository:        repo,
+		Ctx:               ctx,
+		MaxWorkersPerHost: 1,
+	}
+
+	release := make(chan struct{})
+	action := func(_ context.Context, _ chan repository.StatusMessage) (task.Result, error) {
+		<-release
+		return task.Result{Text: "done"}, nil
+	}
+	powerID := xid.New().String()
+	bootID := xid.New().String()
+	otherID := xid.New().String()
+	runner.Execute(ctx, logr.Discard(), "power", powerID, action, task.WithHost("10.1.1.1"))
+	// the same host, written another way.
+	runner.Execute(ctx, logr.Discard(), "boot device", bootID, action, task.WithHost("10.1.1.1:623"))
+	runner.Execute(ctx, logr.Discard(), "power", otherID, action, task.WithHost("10.2.2.2"))
+
+	waitForRecord(t, &runner, powerID, func(r repository.Record) bool { return r.State == "running" })
+	waitForRecord(t, &runner, otherID, func(r repository.Record) bool { return r.State == "running" })
+	record := waitForRecord(t, &runner, bootID, func(r repository.Record) bool { return r.State == "queued" })
+	want := "queued behind task " + powerID + " on host 10.1.1.1"
+	if len(record.Messages) != 1 || record.Messages[0] != want {
+		t.Fatalf("expected message %q, got: %v", want, record.Messages)
+	}
+
+	close(release)
+	for _, id := range []string{powerID, bootID, otherID} {
+		waitForRecord(t, &runner, id, func(r repository.Record) bool { return r.Complete && r.Result == "done" })
+	}
+	if n := runner.QueuedTasks(); n != 0 {
+		t.Fatalf("expected empty queue, got: %v", n)
+	}
+}
+
+// waitForRecord polls the task's record until done returns true, failing the test after a second.
+func waitForRecord(t *testing.T, runner *Runner, taskID string, done func(repository.Record) bool) repository.Record {
+	t.Helper()
+	var record repository.Record
+	for i := 0; i < 200; i++ {
+		record, _ = runner.Status(context.Background(), taskID)
+		if done(record) {
+			return record
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for task %v, got: %+v", taskID, record)
+	return record
+}
@@ -0,0 +1,126 @@
+// Package webhook POSTs the final status of completed tasks to HTTP endpoints.
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "github.com/tinkerbell/pbnj/api/v1"
+	"github.com/tinkerbell/pbnj/grpc/taskrunner"
+	"github.com/tinkerbell/pbnj/pkg/delivery"
+	"github.com/tinkerbell/pbnj/pkg/metrics"
+	"github.com/tinkerbell/pbnj/pkg/repository"
+	"github.com/tinkerbell/pbnj/pkg/task"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// SignatureHeader holds the signature of a signed request, see Sign.
+	SignatureHeader = "X-Pbnj-Signature"
+	// TimestampHeader holds the Unix time a signed request was signed at.
+	TimestampHeader = "X-Pbnj-Timestamp"
+	// DefaultTimeout is how long a single POST may take when no Client is set.
+	DefaultTimeout = delivery.DefaultTimeout
+)
+
+// Notifier POSTs the final v1.StatusResponse of tasks, as JSON, to the callback URL
+// they were started with, or to URL if they have none. Unlike the Task service, the
+// response includes the error of a failed task. Deliveries that fail with a network
+// error, a 429 or a 5xx response are retried with backoff.
+type Notifier struct {
+	// URL receives the tasks started without a callback URL. Empty sends nothing for them.
+	URL string
+	// Secret signs requests, see Sign. Empty sends them unsigned.
+	Secret []byte
+	// RetryPolicy controls the attempts and backoff of deliveries. Its RetryableCodes are not used.
+	RetryPolicy task.RetryPolicy
+	// Client sends the requests. Nil uses a client with DefaultTimeout.
+	Client *http.Client
+	Log    logr.Logger
+	// Ctx stops pending deliveries when done. Nil never stops them.
+	Ctx context.Context
+}
+
+// Emit delivers the final status of completed and failed tasks.
+func (n *Notifier) Emit(e taskrunner.Event) {
+	if e.Type == taskrunner.EventCompleted || e.Type == taskrunner.EventFailed {
+		n.Notify(e.Record)
+	}
+}
+
+// Notify delivers the task's final status in the background.
+func (n *Notifier) Notify(record repository.Record) {
+	url := record.CallbackURL
+	if url == "" {
+		url = n.URL
+	}
+	if url == "" {
+		return
+	}
+	resp := task.StatusResponse(record)
+	if record.Failed() {
+		resp.Error = &v1.Error{
+			Code:    record.Error.Code,
+			Message: record.Error.Message,
+			Details: record.Error.Details,
+		}
+	}
+	body, err := protojson.Marshal(resp)
+	if err != nil {
+		n.Log.Error(err, "unable to encode webhook", "taskID", record.ID)
+		metrics.WebhookDeliveries.WithLabelValues("failed").Inc()
+		return
+	}
+	go n.deliver(record.ID, url, body, time.Now())
+}
+
+func (n *Notifier) deliver(taskID, url string, body []byte, completed time.Time) {
+	logger := n.Log.WithValues("taskID", taskID, "url", url)
+	ctx := n.Ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	result := "failed"
+	defer func() {
+		metrics.WebhookDeliveries.WithLabelValues(result).Inc()
+		metrics.WebhookDeliveryDuration.Observe(time.Since(completed).Seconds())
+	}()
+	r := delivery.Request{URL: url, ContentType: "application/json", Body: body}
+	if len(n.Secret) > 0 {
+		r.Header = func(h http.Header) {
+			timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+			h.Set(TimestampHeader, timestamp)
+			h.Set(SignatureHeader, Sign(n.Secret, timestamp, body))
+		}
+	}
+	attempts, err := delivery.Post(ctx, n.Client, n.RetryPolicy, r, func(attempt int, err error) {
+		logger.Info("webhook delivery failed, retrying", "attempt", attempt, "error", err.Error())
+	})
+	metrics.WebhookAttempts.Add(float64(attempts))
+	if err != nil {
+		logger.Error(err, "unable to deliver webhook", "attempts", attempts)
+		return
+	}
+	result = "delivered"
+}
+
+// Sign returns the signature of a request: "sha256=" followed by the hex encoded
+// HMAC-SHA256, keyed by secret, of the timestamp, a "." and the body.
+// Receivers should check it with Verify and reject requests with old timestamps.
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is the signature of the timestamp and body.
+func Verify(secret []byte, timestamp, signature string, body []byte) bool {
+	return hmac.Equal([]byte(signature), []byte(Sign(secret, timestamp, body)))
+}
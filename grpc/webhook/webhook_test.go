@@ -0,0 +1,150 @@
+package webhook
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v1 "github.com/tinkerbell/pbnj/api/v1"
+	"github.com/tinkerbell/pbnj/grpc/taskrunner"
+	"github.com/tinkerbell/pbnj/pkg/repository"
+	"github.com/tinkerbell/pbnj/pkg/task"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type webhookRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// receiver returns a server that responds to each request with the next of statuses,
+// repeating the last, and sends what it received on the returned channel.
+func receiver(t *testing.T, statuses ...int) (*httptest.Server, chan webhookRequest, *int32) {
+	t.Helper()
+	received := make(chan webhookRequest, 10)
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(atomic.AddInt32(&requests, 1))
+		body, _ := io.ReadAll(r.Body)
+		received <- webhookRequest{header: r.Header, body: body}
+		if n > len(statuses) {
+			n = len(statuses)
+		}
+		w.WriteHeader(statuses[n-1])
+	}))
+	t.Cleanup(srv.Close)
+	return srv, received, &requests
+}
+
+func receive(t *testing.T, received chan webhookRequest) webhookRequest {
+	t.Helper()
+	select {
+	case d := <-received:
+		return d
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for webhook")
+	}
+	return webhookRequest{}
+}
+
+func TestNotify(t *testing.T) {
+	srv, received, _ := receiver(t, http.StatusOK)
+	secret := []byte("s3cret")
+	n := &Notifier{URL: srv.URL, Secret: secret}
+
+	n.Notify(repository.Record{
+		ID:       "task1",
+		State:    "complete",
+		Complete: true,
+		Result:   "action failed",
+		Error:    &repository.Error{Code: v1.Code_value["UNAVAILABLE"], Message: "bmc unreachable"},
+	})
+	d := receive(t, received)
+	if got := d.header.Get("Content-Type"); got != "application/json" {
+		t.Fatalf("expected a JSON body, got content type: %v", got)
+	}
+	if !Verify(secret, d.header.Get(TimestampHeader), d.header.Get(SignatureHeader), d.body) {
+		t.Fatalf("expected a valid signature, got: %v", d.header.Get(SignatureHeader))
+	}
+	if Verify([]byte("wrong"), d.header.Get(TimestampHeader), d.header.Get(SignatureHeader), d.body) {
+		t.Fatal("expected the signature not to verify with another secret")
+	}
+	resp := &v1.StatusResponse{}
+	if err := protojson.Unmarshal(d.body, resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Id != "task1" || !resp.Complete || resp.GetError().GetMessage() != "bmc unreachable" {
+		t.Fatalf("expected the task's final status, got: %v", resp)
+	}
+}
+
+func TestNotifyCallbackURL(t *testing.T) {
+	srv, received, _ := receiver(t, http.StatusOK)
+	other, otherReceived, _ := receiver(t, http.StatusOK)
+	n := &Notifier{URL: other.URL}
+
+	n.Notify(repository.Record{ID: "task1", Complete: true, CallbackURL: srv.URL})
+	if d := receive(t, received); len(d.header.Get(SignatureHeader)) != 0 {
+		t.Fatal("expected an unsigned request without a secret")
+	}
+	select {
+	case <-otherReceived:
+		t.Fatal("expected the callback URL to be used instead of the default URL")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// without any URL nothing is sent.
+	n.URL = ""
+	n.Notify(repository.Record{ID: "task2", Complete: true})
+	select {
+	case <-otherReceived:
+		t.Fatal("expected no request without a URL")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestNotifyRetry(t *testing.T) {
+	testCases := map[string]struct {
+		statuses []int
+		want     int32
+	}{
+		"retried until delivered": {statuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusNoContent}, want: 3},
+		"gives up after attempts": {statuses: []int{http.StatusInternalServerError}, want: 4},
+		"client errors not tried": {statuses: []int{http.StatusBadRequest}, want: 1},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			srv, received, requests := receiver(t, tc.statuses...)
+			n := &Notifier{
+				URL:         srv.URL,
+				RetryPolicy: task.RetryPolicy{MaxAttempts: 4, InitialBackoff: time.Millisecond},
+			}
+			n.Notify(repository.Record{ID: "task1", Complete: true})
+			for i := int32(0); i < tc.want; i++ {
+				receive(t, received)
+			}
+			time.Sleep(50 * time.Millisecond)
+			if got := atomic.LoadInt32(requests); got != tc.want {
+				t.Fatalf("expected %v requests, got: %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestEmit(t *testing.T) {
+	srv, received, _ := receiver(t, http.StatusOK)
+	n := &Notifier{URL: srv.URL}
+
+	n.Emit(taskrunner.Event{Type: taskrunner.EventStarted, Record: repository.Record{ID: "task1"}})
+	n.Emit(taskrunner.Event{Type: taskrunner.EventFailed, Record: repository.Record{ID: "task1", Complete: true}})
+	resp := &v1.StatusResponse{}
+	if err := protojson.Unmarshal(receive(t, received).body, resp); err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Complete {
+		t.Fatalf("expected only the final status to be sent, got: %v", resp)
+	}
+}
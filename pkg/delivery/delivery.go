@@ -0,0 +1,72 @@
+// Package delivery POSTs task notifications to HTTP endpoints, retrying failed deliveries.
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/tinkerbell/pbnj/pkg/task"
+)
+
+// DefaultTimeout is how long a single POST may take when no client is given.
+const DefaultTimeout = 10 * time.Second
+
+// Request is a POST to deliver.
+type Request struct {
+	URL         string
+	ContentType string
+	Body        []byte
+	// Header, if set, adds headers to every attempt, such as a signature of when it was sent.
+	Header func(http.Header)
+}
+
+// Post sends the request with client, or a client with DefaultTimeout if it is nil.
+// Attempts that fail with a network error, a 429 or a 5xx response are retried with
+// the policy's backoff, its RetryableCodes are not used. Retrying, if set, is called
+// with the error of every attempt that is retried. It returns the attempts made.
+func Post(ctx context.Context, client *http.Client, p task.RetryPolicy, r Request, retrying func(attempt int, err error)) (int, error) {
+	if client == nil {
+		client = &http.Client{Timeout: DefaultTimeout}
+	}
+	attempts := p.Attempts()
+	for attempt := 1; ; attempt++ {
+		retry, err := post(ctx, client, r)
+		if err == nil || !retry || attempt >= attempts {
+			return attempt, err
+		}
+		if retrying != nil {
+			retrying(attempt, err)
+		}
+		select {
+		case <-ctx.Done():
+			return attempt, ctx.Err()
+		case <-time.After(p.Backoff(attempt)):
+		}
+	}
+}
+
+// post sends the request once, reporting whether a failure is worth retrying.
+func post(ctx context.Context, client *http.Client, r Request) (retry bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.URL, bytes.NewReader(r.Body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", r.ContentType)
+	if r.Header != nil {
+		r.Header(req.Header)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500, fmt.Errorf("unexpected response status: %v", resp.Status)
+}
@@ -0,0 +1,56 @@
+package delivery
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tinkerbell/pbnj/pkg/task"
+)
+
+func TestPost(t *testing.T) {
+	testCases := map[string]struct {
+		statuses []int
+		want     int
+		wantErr  bool
+	}{
+		"delivered":               {statuses: []int{http.StatusNoContent}, want: 1},
+		"retried until delivered": {statuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK}, want: 3},
+		"gives up after attempts": {statuses: []int{http.StatusInternalServerError}, want: 4, wantErr: true},
+		"client errors not tried": {statuses: []int{http.StatusBadRequest}, want: 1, wantErr: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var requests int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := int(atomic.AddInt32(&requests, 1))
+				if r.Header.Get("Content-Type") != "application/test" || r.Header.Get("X-Attempt") == "" {
+					t.Errorf("expected the request's headers on every attempt, got: %v", r.Header)
+				}
+				w.WriteHeader(tc.statuses[min(n, len(tc.statuses))-1])
+			}))
+			defer srv.Close()
+
+			var retried int
+			r := Request{
+				URL:         srv.URL,
+				ContentType: "application/test",
+				Body:        []byte("{}"),
+				Header:      func(h http.Header) { h.Set("X-Attempt", "yes") },
+			}
+			attempts, err := Post(context.Background(), nil, task.RetryPolicy{MaxAttempts: 4, InitialBackoff: time.Millisecond}, r, func(int, error) { retried++ })
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expected error %v, got: %v", tc.wantErr, err)
+			}
+			if attempts != tc.want || int(atomic.LoadInt32(&requests)) != tc.want {
+				t.Fatalf("expected %v attempts, got: %v", tc.want, attempts)
+			}
+			if retried != tc.want-1 {
+				t.Fatalf("expected %v retries, got: %v", tc.want-1, retried)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Package logfile appends lines to files that are rotated by size.
+package logfile
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+// DefaultMaxSize is the size in bytes at which a File is rotated.
+const DefaultMaxSize = 100 << 20
+
+// File appends lines to a file, which is only ever appended to. When writing a
+// line would grow it beyond its maximum size, it is renamed to path.1, existing
+// backups are shifted to path.2 and so on, and a new file is started. The oldest
+// backups beyond the maximum number of backups are removed.
+type File struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+	mu         sync.Mutex
+	file       *os.File
+	size       int64
+}
+
+// Open returns a File that appends to the file at path, creating it if needed.
+// A maxSize of zero uses DefaultMaxSize, a maxBackups of zero keeps every backup.
+func Open(path string, maxSize int64, maxBackups int) (*File, error) {
+	if maxSize <= 0 {
+		maxSize = DefaultMaxSize
+	}
+	f := &File{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Write appends the line to the file, rotating it first if it is full.
+// The line is still written when only the rotation fails.
+func (f *File) Write(line []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var rotateErr error
+	if f.size > 0 && f.size+int64(len(line)) > f.maxSize {
+		rotateErr = f.rotate()
+	}
+	n, err := f.file.Write(line)
+	f.size += int64(n)
+	if err != nil {
+		return n, err
+	}
+	return n, rotateErr
+}
+
+// Close closes the file.
+func (f *File) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.file.Close()
+}
+
+func (f *File) open() error {
+	file, size, err := openFile(f.path)
+	if err != nil {
+		return err
+	}
+	f.file = file
+	f.size = size
+	return nil
+}
+
+// openFile opens the file at path for appending and returns its size.
+func openFile(path string) (*os.File, int64, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, 0, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, 0, err
+	}
+	return file, info.Size(), nil
+}
+
+// rotate moves the current file to the first backup and switches to a new one.
+// The current file is only closed once the new one is open, so if the backups
+// can't be moved or the new file can't be opened, lines keep being appended to
+// the current file, which grows past maxSize.
+func (f *File) rotate() error {
+	if err := f.shift(); err != nil {
+		return err
+	}
+	file, size, err := openFile(f.path)
+	if err != nil {
+		// move the current file back, so that the next rotation doesn't shift it again.
+		_ = os.Rename(f.backup(1), f.path)
+		return err
+	}
+	closeErr := f.file.Close()
+	f.file = file
+	f.size = size
+	return closeErr
+}
+
+// shift renames the file and its backups to the next backup number.
+func (f *File) shift() error {
+	n := 1
+	for ; f.maxBackups == 0 || n < f.maxBackups; n++ {
+		if _, err := os.Stat(f.backup(n)); os.IsNotExist(err) {
+			break
+		}
+	}
+	if f.maxBackups > 0 {
+		// the backup at maxBackups, if any, is overwritten below.
+		_ = os.Remove(f.backup(n))
+	}
+	for ; n > 1; n-- {
+		if err := os.Rename(f.backup(n-1), f.backup(n)); err != nil {
+			return err
+		}
+	}
+	return os.Rename(f.path, f.backup(1))
+}
+
+func (f *File) backup(n int) string {
+	return fmt.Sprintf("%s.%d", f.path, n)
+}
@@ -0,0 +1,70 @@
+package logfile
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.log")
+	line := []byte("line\n")
+	// room for two lines per file.
+	f, err := Open(path, int64(2*len(line)), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	for i := 0; i < 7; i++ {
+		if _, err := f.Write(line); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := map[string]int{path: 1, path + ".1": 2, path + ".2": 2}
+	for p, n := range want {
+		b, err := os.ReadFile(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := bytes.Count(b, line); got != n {
+			t.Fatalf("expected %v lines in %v, got: %v", n, p, got)
+		}
+	}
+	if _, err := os.Stat(path + ".3"); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected only 2 backups, got: %v", err)
+	}
+}
+
+func TestRotationFailure(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.log")
+	// a non-empty directory in the way of the backup makes the rotation fail.
+	if err := os.MkdirAll(filepath.Join(path+".1", "dir"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	line := []byte("line\n")
+	f, err := Open(path, int64(len(line)), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.Write(line); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := f.Write(line); err == nil || n != len(line) {
+		t.Fatalf("expected the line to be written and the rotation error returned, got: %v, %v", n, err)
+	}
+	if _, err := f.Write(line); err == nil {
+		t.Fatal("expected the rotation to be tried again")
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := bytes.Count(b, line); got != 3 {
+		t.Fatalf("expected the current file to keep every line, got: %v", got)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/go-logr/logr"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	"github.com/rs/xid"
 	v1 "github.com/tinkerbell/pbnj/api/v1"
@@ -18,6 +19,10 @@ type ctxLogr struct{}
 
 var ctxMarkerKey = &ctxLogr{}
 
+type ctxRequestID struct{}
+
+var ctxRequestIDKey = &ctxRequestID{}
+
 // UnaryServerInterceptor returns a new unary server interceptors that adds logr.Logger to the context.
 func UnaryServerInterceptor(logger logr.Logger) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
@@ -31,27 +36,58 @@ func UnaryServerInterceptor(logger logr.Logger) grpc.UnaryServerInterceptor {
 // UnaryLogRequestID returns a new unary server interceptors that adds logr.Logger with requestID to the context if a requestID doesnt exist.
 func UnaryLogRequestID(requestIDKey, requestIDLogKey string) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		var requestID string
-		data, ok := metadata.FromIncomingContext(ctx)
-		if ok {
-			reqID := data.Get(requestIDKey)
-			if len(reqID) > 0 {
-				requestID = reqID[0]
-			} else {
-				id := xid.New()
-				requestID = id.String()
-				md, _ := metadata.FromIncomingContext(ctx)
-				md.Append(requestIDKey, id.String())
-				ctx = metadata.NewOutgoingContext(ctx, md)
-			}
-		}
-		logger := ExtractLogr(ctx).WithValues(requestIDLogKey, requestID)
-		ctx = context.WithValue(ctx, ctxMarkerKey, logger)
+		return handler(withRequestID(ctx, requestIDKey, requestIDLogKey), req)
+	}
+}
 
-		return handler(ctx, req)
+// StreamServerInterceptor returns a new stream server interceptors that adds logr.Logger to the context.
+func StreamServerInterceptor(logger logr.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		l := logger.WithValues("grpc.method", path.Base(info.FullMethod), "grpc.service", strings.TrimPrefix(path.Dir(info.FullMethod), "/"))
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = context.WithValue(stream.Context(), ctxMarkerKey, l)
+
+		return handler(srv, wrapped)
 	}
 }
 
+// StreamLogRequestID returns a new stream server interceptors that adds logr.Logger with requestID to the context if a requestID doesnt exist.
+func StreamLogRequestID(requestIDKey, requestIDLogKey string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = withRequestID(stream.Context(), requestIDKey, requestIDLogKey)
+
+		return handler(srv, wrapped)
+	}
+}
+
+// withRequestID adds the requestID from the incoming metadata, or a new one, to the logger in the context.
+func withRequestID(ctx context.Context, requestIDKey, requestIDLogKey string) context.Context {
+	var requestID string
+	data, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		reqID := data.Get(requestIDKey)
+		if len(reqID) > 0 {
+			requestID = reqID[0]
+		} else {
+			id := xid.New()
+			requestID = id.String()
+			md, _ := metadata.FromIncomingContext(ctx)
+			md.Append(requestIDKey, id.String())
+			ctx = metadata.NewOutgoingContext(ctx, md)
+		}
+	}
+	logger := ExtractLogr(ctx).WithValues(requestIDLogKey, requestID)
+	ctx = context.WithValue(ctx, ctxRequestIDKey, requestID)
+	return context.WithValue(ctx, ctxMarkerKey, logger)
+}
+
+// RequestID returns the requestID added to the context by UnaryLogRequestID or StreamLogRequestID.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(ctxRequestIDKey).(string)
+	return id
+}
+
 // UnaryLogBMCIP returns a new unary server interceptors that adds the BMC IP to the logger.
 func UnaryLogBMCIP() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (h interface{}, err error) {
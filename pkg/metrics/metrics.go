@@ -9,6 +9,19 @@ var (
 	ActionDuration prometheus.ObserverVec
 	TasksTotal     prometheus.Counter
 	TasksActive    prometheus.Gauge
+	TasksReaped    prometheus.Counter
+	TasksQueued    prometheus.Gauge
+	TasksScheduled prometheus.Gauge
+	TaskQueueWait  prometheus.Observer
+
+	TaskUpdateConflicts prometheus.Counter
+	TasksTakenOver      prometheus.Counter
+
+	WebhookDeliveries       *prometheus.CounterVec
+	WebhookAttempts         prometheus.Counter
+	WebhookDeliveryDuration prometheus.Observer
+
+	EventsEmitted *prometheus.CounterVec
 )
 
 func init() {
@@ -22,6 +35,9 @@ func init() {
 		{"service": "bmc", "action": "create_user"},
 		{"service": "bmc", "action": "update_user"},
 		{"service": "bmc", "action": "delete_user"},
+		{"service": "bmc", "action": "network_source"},
+		{"service": "bmc", "action": "get_network_config"},
+		{"service": "bmc", "action": "set_network_config"},
 		{"service": "machine", "action": "boot_device"},
 		{"service": "machine", "action": "power"},
 	}
@@ -36,6 +52,52 @@ func init() {
 		Name: "pbnj_tasks_active",
 		Help: "Number of tasks currently active.",
 	})
+	TasksReaped = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pbnj_tasks_reaped_total",
+		Help: "Total number of expired task records deleted.",
+	})
+	TasksQueued = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "pbnj_tasks_queued",
+		Help: "Number of tasks waiting for a free worker.",
+	})
+	TasksScheduled = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "pbnj_tasks_scheduled",
+		Help: "Number of tasks waiting for their scheduled start.",
+	})
+	TaskQueueWait = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "pbnj_task_queue_wait_seconds",
+		Help:    "Time tasks waited for a free worker.",
+		Buckets: []float64{0.01, 0.1, 0.5, 1, 2, 5, 10, 30, 60, 120, 300},
+	})
+	TaskUpdateConflicts = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pbnj_task_update_conflicts_total",
+		Help: "Total number of task record updates retried because another writer changed the record.",
+	})
+	TasksTakenOver = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pbnj_tasks_taken_over_total",
+		Help: "Total number of tasks taken over from replicas whose leases expired.",
+	})
+
+	WebhookDeliveries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pbnj_webhook_deliveries_total",
+		Help: "Total number of task webhooks, by whether they were delivered or failed after all attempts.",
+	}, []string{"result"})
+	WebhookDeliveries.WithLabelValues("delivered")
+	WebhookDeliveries.WithLabelValues("failed")
+	WebhookAttempts = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pbnj_webhook_attempts_total",
+		Help: "Total number of task webhook POST attempts, including retries.",
+	})
+	WebhookDeliveryDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "pbnj_webhook_delivery_duration_seconds",
+		Help:    "Time from a task completing to its webhook being delivered or given up on.",
+		Buckets: []float64{0.05, 0.1, 0.5, 1, 2, 5, 10, 30, 60, 120, 300},
+	})
+
+	EventsEmitted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pbnj_events_emitted_total",
+		Help: "Total number of task lifecycle CloudEvents, by sink and whether they were delivered, failed or dropped.",
+	}, []string{"sink", "result"})
 }
 
 func initObserverLabels(m prometheus.ObserverVec, l []prometheus.Labels) {
@@ -9,12 +9,17 @@ import (
 
 // BMC management methods.
 type BMC interface {
-	// NetworkSource() (result string, err repository.Error)
 	CreateUser(context.Context) error
 	UpdateUser(context.Context) error
 	DeleteUser(context.Context) error
 }
 
+// NetworkSourceSetter switches the network configuration of a BMC's own interface
+// between DHCP and static.
+type NetworkSourceSetter interface {
+	SetNetworkSource(context.Context) error
+}
+
 // CreateUser interface function.
 func CreateUser(ctx context.Context, u []BMC) (err error) {
 	for _, elem := range u {
@@ -80,3 +85,25 @@ func DeleteUser(ctx context.Context, u []BMC) (err error) {
 	}
 	return multierror.Append(err, errors.New("delete user failed"))
 }
+
+// SetNetworkSource interface function.
+func SetNetworkSource(ctx context.Context, n []NetworkSourceSetter) (err error) {
+	for _, elem := range n {
+		select {
+		case <-ctx.Done():
+			err = multierror.Append(err, ctx.Err())
+			break
+		default:
+			if elem != nil {
+				setErr := elem.SetNetworkSource(ctx)
+				if setErr != nil {
+					err = multierror.Append(err, setErr)
+					continue
+				}
+				return nil
+			}
+			err = multierror.Append(err, errors.New("set network source request not executed"))
+		}
+	}
+	return multierror.Append(err, errors.New("set network source failed"))
+}
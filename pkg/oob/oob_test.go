@@ -56,6 +56,13 @@ func (o *OOBTester) DeleteUser(_ context.Context) (err error) {
 	return nil
 }
 
+func (o *OOBTester) SetNetworkSource(_ context.Context) (err error) {
+	if o.MakeFail {
+		return errors.New("set network source failed")
+	}
+	return nil
+}
+
 func TestCreateUser(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -151,3 +158,35 @@ func TestDeleteUser(t *testing.T) {
 		})
 	}
 }
+
+func TestSetNetworkSource(t *testing.T) {
+	testCases := []struct {
+		name       string
+		makeFail   bool
+		err        error
+		ctxTimeout time.Duration
+	}{
+		{name: "success", err: nil},
+		{name: "Set Network Source fails", makeFail: true, err: &multierror.Error{Errors: []error{errors.New("set network source failed"), errors.New("set network source failed")}}},
+		{name: "error context timeout", makeFail: true, err: &multierror.Error{Errors: []error{errors.New("context deadline exceeded"), errors.New("set network source failed")}}, ctxTimeout: 1 * time.Nanosecond},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			testImplementation := OOBTester{MakeFail: tc.makeFail}
+			if tc.ctxTimeout == 0 {
+				tc.ctxTimeout = time.Second * 3
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), tc.ctxTimeout)
+			defer cancel()
+			err := SetNetworkSource(ctx, []NetworkSourceSetter{&testImplementation})
+			if err != nil {
+				diff := cmp.Diff(tc.err.Error(), err.Error())
+				if diff != "" {
+					t.Fatal(diff)
+				}
+			}
+		})
+	}
+}
@@ -2,14 +2,60 @@
 // putting tasks into a persistence layer.
 package repository
 
-import "fmt"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// ErrConflict is returned by Update when the stored record has changed since
+// the given record was read, i.e. their Versions differ.
+var ErrConflict = errors.New("record version conflict")
+
+// ErrNotFound is returned by Get, Update and Delete when there is no record with the given id.
+var ErrNotFound = errors.New("record id not found")
 
 // Actions interface for interacting with the persistence layer.
+// Update is a compare-and-swap: it only writes the record when the stored
+// record has the same Version, and stores it with the Version incremented.
+// Delete fails with ErrNotFound when there was no record to delete, so that of
+// several clients deleting the same record only one succeeds.
+// ClaimKey atomically claims key for id, unless an unexpired claim holds it, and
+// returns the id holding the key. The claim expires after ttl, or never if ttl is
+// zero. ReleaseKey drops the claim on key if id holds it. Keys are claimed apart
+// from records, so List does not see them.
 type Actions interface {
 	Create(id string, val Record) error
 	Get(id string) (Record, error)
 	Update(id string, val Record) error
 	Delete(id string) error
+	List(filter Filter) ([]Record, error)
+	ClaimKey(key, id string, ttl time.Duration) (string, error)
+	ReleaseKey(key, id string) error
+}
+
+// KeyClaim is the holder of a key claimed with ClaimKey.
+type KeyClaim struct {
+	ID string
+	// ExpiresAt is when the claim stops holding its key, zero for never.
+	ExpiresAt time.Time `json:",omitempty"`
+}
+
+// NewKeyClaim returns the claim of id that expires after ttl, or never if ttl is zero.
+func NewKeyClaim(id string, ttl time.Duration) KeyClaim {
+	c := KeyClaim{ID: id}
+	if ttl > 0 {
+		c.ExpiresAt = time.Now().UTC().Add(ttl)
+	}
+	return c
+}
+
+// Held reports whether the claim holds its key at now.
+func (c KeyClaim) Held(now time.Time) bool {
+	return c.ID != "" && (c.ExpiresAt.IsZero() || now.Before(c.ExpiresAt))
 }
 
 // Record that is stored in the repo.
@@ -19,8 +65,150 @@ type Record struct {
 	Error       *Error
 	State       string
 	Result      string
+	// TypedResult is the protojson encoded v1.TaskResult of a successful task, if its action has one.
+	TypedResult json.RawMessage `json:",omitempty"`
 	Complete    bool
 	Messages    []string
+	// StatusMessages are the structured form of Messages, which holds their text.
+	StatusMessages []StatusMessage
+	// Host is the BMC the task acts on.
+	Host string
+	// CreatedAt is when the task record was first written.
+	CreatedAt time.Time
+	// NotBefore is when a scheduled task may start. It is the zero time for tasks
+	// that start right away.
+	NotBefore time.Time
+	// StartedAt is when the task left the queue and its action started.
+	StartedAt time.Time
+	// FinishedAt is when the task completed, successfully or not.
+	// It is the zero time while the task is still running.
+	FinishedAt time.Time
+	// Attempts is the number of times the task's action has been attempted.
+	Attempts int
+	// IdempotencyKey is the key the task was started with, if any.
+	IdempotencyKey string
+	// Owner is the ID of the runner that runs the task, the one that started it
+	// unless another runner took it over.
+	Owner string
+	// LeaseExpiresAt is when the Owner's claim on an incomplete task runs out unless
+	// the Owner renews it. Other runners take over tasks whose lease has expired.
+	// It is the zero time when the Owner does not use leases.
+	LeaseExpiresAt time.Time
+	// CancelRequested is set when a runner other than the Owner is asked to cancel the
+	// task. The Owner cancels the task the next time it renews its lease.
+	CancelRequested bool
+	// Rerun is how to run the task again if its owner stops before the task completes.
+	// It is only set for tasks that are safe to repeat, cleared once the task completes,
+	// and for tasks that are only started again if they had not started, once they start.
+	Rerun *Rerun `json:",omitempty"`
+	// CallbackURL is where the task's final status is POSTed once it completes, if requested.
+	CallbackURL string
+	// RequestID and TraceID identify the request that started the task and its otel trace.
+	RequestID string
+	TraceID   string
+	// Version is incremented by every Update. Writers pass the Version they read,
+	// so an Update based on a stale record fails with ErrConflict instead of
+	// overwriting changes made since.
+	Version int64
+}
+
+// Rerun holds what is needed to run a task again from scratch.
+type Rerun struct {
+	// Kind selects the function that rebuilds the task's action from Request.
+	Kind string
+	// Request is the encoded request the task was started with. Only records stored
+	// before requests were encrypted have it, the others have Sealed.
+	Request json.RawMessage `json:",omitempty"`
+	// Sealed is Request encrypted by the runner, as it holds the BMC credentials.
+	Sealed []byte `json:",omitempty"`
+	// UntilStarted is set when the task is not safe to repeat, so it is only
+	// run after a restart if it had not started yet.
+	UntilStarted bool `json:",omitempty"`
+}
+
+// AddMessage appends a status message to the record.
+func (r *Record) AddMessage(m StatusMessage) {
+	r.StatusMessages = append(r.StatusMessages, m)
+	r.Messages = append(r.Messages, m.Text)
+}
+
+// Status message levels.
+const (
+	LevelInfo    = "info"
+	LevelWarning = "warning"
+	LevelError   = "error"
+)
+
+// StatusMessage is a progress update from a task.
+type StatusMessage struct {
+	Time time.Time
+	// Level is one of LevelInfo, LevelWarning or LevelError.
+	Level string
+	// Provider is the bmclib provider the message is about, when known.
+	Provider string
+	Text     string
+}
+
+// NewStatusMessage returns a status message timestamped now.
+func NewStatusMessage(level, provider, text string) StatusMessage {
+	return StatusMessage{Time: time.Now().UTC(), Level: level, Provider: provider, Text: text}
+}
+
+// Failed reports whether a completed task ended in an error.
+func (r Record) Failed() bool {
+	return r.Error != nil && r.Error.Message != ""
+}
+
+// Filter selects records in a List call. The zero Filter matches every record.
+type Filter struct {
+	// States matches records in any of the given states.
+	States []string
+	// Complete, when set, matches records with the same completion.
+	Complete *bool
+	// Host matches records against the given BMC host, however either address is written, see HostKey.
+	Host string
+	// Description matches records whose description contains the text, ignoring case.
+	Description string
+	// CreatedAfter and CreatedBefore bound the record creation time, exclusively.
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	// IdempotencyKey matches records started with the given idempotency key.
+	IdempotencyKey string
+}
+
+// Match reports whether the record is selected by the filter.
+func (f Filter) Match(r Record) bool {
+	if len(f.States) > 0 {
+		var found bool
+		for _, s := range f.States {
+			if s == r.State {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if f.Complete != nil && *f.Complete != r.Complete {
+		return false
+	}
+	if f.Host != "" && HostKey(f.Host) != HostKey(r.Host) {
+		return false
+	}
+	if f.Description != "" && !strings.Contains(strings.ToLower(r.Description), strings.ToLower(f.Description)) {
+		return false
+	}
+	if !f.CreatedAfter.IsZero() && !r.CreatedAt.After(f.CreatedAfter) {
+		return false
+	}
+	if !f.CreatedBefore.IsZero() && !r.CreatedAt.Before(f.CreatedBefore) {
+		return false
+	}
+	if f.IdempotencyKey != "" && f.IdempotencyKey != r.IdempotencyKey {
+		return false
+	}
+	return true
 }
 
 // Error for all bmc actions.
@@ -42,3 +230,20 @@ func (e *Error) StructuredError() *Error {
 		Details: e.Details,
 	}
 }
+
+// defaultBMCPorts are the ports a BMC is reached on when its host names none:
+// HTTPS for Redfish and the RMCP port for IPMI.
+var defaultBMCPorts = map[string]bool{"443": true, "623": true}
+
+// HostKey returns the same key for the ways of writing the same BMC address,
+// e.g. "BMC.example.com" and "bmc.example.com:443".
+func HostKey(host string) string {
+	host = strings.ToLower(strings.TrimSpace(host))
+	if h, port, err := net.SplitHostPort(host); err == nil && defaultBMCPorts[port] {
+		return h
+	}
+	if strings.HasPrefix(host, "[") && strings.HasSuffix(host, "]") {
+		return host[1 : len(host)-1]
+	}
+	return host
+}
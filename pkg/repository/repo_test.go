@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"testing"
+)
+
+func TestHostKey(t *testing.T) {
+	testCases := map[string]struct {
+		host string
+		want string
+	}{
+		"ip":                  {host: "10.1.1.1", want: "10.1.1.1"},
+		"redfish port":        {host: "10.1.1.1:443", want: "10.1.1.1"},
+		"ipmi port":           {host: "10.1.1.1:623", want: "10.1.1.1"},
+		"other port":          {host: "10.1.1.1:8443", want: "10.1.1.1:8443"},
+		"name":                {host: " BMC.Example.com ", want: "bmc.example.com"},
+		"name and port":       {host: "BMC.example.com:443", want: "bmc.example.com"},
+		"ipv6":                {host: "FE80::1", want: "fe80::1"},
+		"bracketed ipv6":      {host: "[fe80::1]", want: "fe80::1"},
+		"ipv6 and port":       {host: "[fe80::1]:443", want: "fe80::1"},
+		"ipv6 and other port": {host: "[fe80::1]:8443", want: "[fe80::1]:8443"},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := HostKey(tc.host); got != tc.want {
+				t.Fatalf("expected %q, got: %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestFilterMatchHost(t *testing.T) {
+	testCases := map[string]struct {
+		filter string
+		host   string
+		want   bool
+	}{
+		"same":        {filter: "10.0.0.1", host: "10.0.0.1", want: true},
+		"ipmi port":   {filter: "10.0.0.1", host: "10.0.0.1:623", want: true},
+		"filter port": {filter: "10.0.0.1:443", host: "10.0.0.1", want: true},
+		"case":        {filter: "BMC.example.com", host: "bmc.example.com:443", want: true},
+		"other port":  {filter: "10.0.0.1", host: "10.0.0.1:8443"},
+		"other host":  {filter: "10.0.0.1", host: "10.0.0.2"},
+		"no host":     {filter: "10.0.0.1"},
+		"no filter":   {host: "10.0.0.1", want: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := (Filter{Host: tc.filter}).Match(Record{Host: tc.host}); got != tc.want {
+				t.Fatalf("expected %v, got: %v", tc.want, got)
+			}
+		})
+	}
+}
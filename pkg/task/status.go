@@ -0,0 +1,67 @@
+package task
+
+import (
+	"strings"
+	"time"
+
+	v1 "github.com/tinkerbell/pbnj/api/v1"
+	"github.com/tinkerbell/pbnj/pkg/repository"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// StatusResponse converts a task record to its API form. The error of a failed
+// task is left out, as the Task service returns it as the gRPC status instead.
+func StatusResponse(record repository.Record) *v1.StatusResponse {
+	resp := &v1.StatusResponse{
+		Id:          record.ID,
+		Description: record.Description,
+		Error:       nil,
+		State:       record.State,
+		Result:      record.Result,
+		Complete:    record.Complete,
+		Messages:    record.Messages,
+		Host:        record.Host,
+		CreatedAt:   timestamp(record.CreatedAt),
+		NotBefore:   timestamp(record.NotBefore),
+		StartedAt:   timestamp(record.StartedAt),
+		FinishedAt:  timestamp(record.FinishedAt),
+		Attempts:    int32(record.Attempts),
+		Owner:       record.Owner,
+	}
+	for _, m := range record.StatusMessages {
+		resp.StatusMessages = append(resp.StatusMessages, &v1.StatusMessage{
+			Time:     timestamp(m.Time),
+			Level:    v1.StatusMessage_Level(v1.StatusMessage_Level_value["LEVEL_"+strings.ToUpper(m.Level)]),
+			Provider: m.Provider,
+			Text:     m.Text,
+		})
+	}
+	if !record.Complete {
+		resp.LeaseExpiresAt = timestamp(record.LeaseExpiresAt)
+	}
+	if !record.StartedAt.IsZero() {
+		end := time.Now()
+		if !record.FinishedAt.IsZero() {
+			end = record.FinishedAt
+		}
+		resp.Duration = durationpb.New(end.Sub(record.StartedAt))
+	}
+	if len(record.TypedResult) > 0 {
+		// A result that cannot be decoded is left unset; the Result string still carries it.
+		typed := &v1.TaskResult{}
+		if err := protojson.Unmarshal(record.TypedResult, typed); err == nil {
+			resp.TypedResult = typed
+		}
+	}
+	return resp
+}
+
+// timestamp converts t to a proto timestamp, leaving it unset when t is zero.
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -2,13 +2,197 @@ package task
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/go-logr/logr"
+	v1 "github.com/tinkerbell/pbnj/api/v1"
 	"github.com/tinkerbell/pbnj/pkg/repository"
 )
 
+// IdempotencyKeyHeader is the gRPC metadata key of a task request's idempotency key.
+// Requests repeated with the same key return the ID of the task the first one started,
+// if they are for the same action against the same host.
+const IdempotencyKeyHeader = "idempotency-key"
+
+// ErrNotRunning is returned when cancelling a task that exists but is no longer running.
+var ErrNotRunning = errors.New("task is not running")
+
+// ErrRunningElsewhere is returned when cancelling a task that another server runs and
+// that server can't be asked to cancel it.
+var ErrRunningElsewhere = errors.New("task is running on another server")
+
 // Task interface for doing BMC actions.
 type Task interface {
-	Execute(ctx context.Context, l logr.Logger, description, taskID string, action func(chan string) (string, error))
+	// Execute starts the action in the background and returns the ID of the task running it.
+	// This is taskID unless the options carry the idempotency key of an earlier task.
+	Execute(ctx context.Context, l logr.Logger, description, taskID string, action func(context.Context, chan repository.StatusMessage) (Result, error), opts ...Option) string
 	Status(ctx context.Context, taskID string) (record repository.Record, err error)
+	Cancel(ctx context.Context, taskID string) error
+	List(ctx context.Context, filter repository.Filter) ([]repository.Record, error)
+	Watch(ctx context.Context, taskID string) (<-chan repository.Record, error)
+}
+
+// Result of a task's action.
+type Result struct {
+	// Text is recorded as the task's result.
+	Text string
+	// Typed is the structured form of the result, when the action has one.
+	Typed *v1.TaskResult
+}
+
+// Options for a single task execution.
+type Options struct {
+	// RetryPolicy overrides fields of the runner's default retry policy.
+	RetryPolicy *RetryPolicy
+	// Host is the BMC the task acts on, recorded for listing tasks by host.
+	Host string
+	// IdempotencyKey identifies repeats of the same request.
+	IdempotencyKey string
+	// Rerun marks the task as safe to run again after a restart, or to start after
+	// a restart when it had not started yet.
+	Rerun *repository.Rerun
+	// CallbackURL receives the task's final status once it completes.
+	CallbackURL string
+	// NotBefore is the time before which the task does not start.
+	NotBefore time.Time
+	// RequestID and TraceID identify the request that started the task and its otel trace.
+	// Execute sets them from its context.
+	RequestID string
+	TraceID   string
+}
+
+// Option to add to a task execution.
+type Option func(o *Options)
+
+// WithRetryPolicy overrides the runner's default retry policy with the non-zero fields of p.
+func WithRetryPolicy(p RetryPolicy) Option {
+	return func(o *Options) {
+		o.RetryPolicy = &p
+	}
+}
+
+// WithHost records the BMC host the task acts on.
+func WithHost(host string) Option {
+	return func(o *Options) {
+		o.Host = host
+	}
+}
+
+// WithIdempotencyKey starts the task only if no earlier task was started with the same key.
+func WithIdempotencyKey(key string) Option {
+	return func(o *Options) {
+		o.IdempotencyKey = key
+	}
+}
+
+// WithRerun marks the task as safe to run again if the server stops before it completes.
+// The request is stored with the task until it completes, encrypted by the runner, and
+// passed to the rerun function of the given kind to rebuild the task's action.
+func WithRerun(kind string, request []byte) Option {
+	return func(o *Options) {
+		o.Rerun = &repository.Rerun{Kind: kind, Request: request}
+	}
+}
+
+// WithScheduledRerun lets a task that has not started yet, e.g. because it is scheduled,
+// be started after a restart. Unlike with WithRerun, the task is not run again once it
+// started, and the request is only stored with the task until then. It does not replace
+// the Rerun of WithRerun.
+func WithScheduledRerun(kind string, request []byte) Option {
+	return func(o *Options) {
+		if o.Rerun == nil {
+			o.Rerun = &repository.Rerun{Kind: kind, Request: request, UntilStarted: true}
+		}
+	}
+}
+
+// WithCallbackURL has the task's final status POSTed to url once it completes.
+func WithCallbackURL(url string) Option {
+	return func(o *Options) {
+		o.CallbackURL = url
+	}
+}
+
+// WithNotBefore holds the task in the "scheduled" state until t.
+func WithNotBefore(t time.Time) Option {
+	return func(o *Options) {
+		o.NotBefore = t
+	}
+}
+
+// RetryPolicy controls how a failing task action is retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first.
+	// Values below 1 are treated as 1, no retries.
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between retries. Zero means no cap.
+	MaxBackoff time.Duration
+	// Multiplier grows the wait after each retry. Values below 1 keep the wait constant.
+	Multiplier float64
+	// RetryableCodes are the v1.Code values of errors that are retried.
+	// Errors that are not a *repository.Error are treated as UNKNOWN.
+	RetryableCodes []int32
+}
+
+// Override returns a copy of p with the non-zero fields of o applied.
+func (p RetryPolicy) Override(o RetryPolicy) RetryPolicy {
+	if o.MaxAttempts > 0 {
+		p.MaxAttempts = o.MaxAttempts
+	}
+	if o.InitialBackoff > 0 {
+		p.InitialBackoff = o.InitialBackoff
+	}
+	if o.MaxBackoff > 0 {
+		p.MaxBackoff = o.MaxBackoff
+	}
+	if o.Multiplier > 0 {
+		p.Multiplier = o.Multiplier
+	}
+	if len(o.RetryableCodes) > 0 {
+		p.RetryableCodes = o.RetryableCodes
+	}
+	return p
+}
+
+// Attempts returns the total number of attempts allowed.
+func (p RetryPolicy) Attempts() int {
+	if p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// Backoff returns the wait before the given retry, where 1 is the first retry.
+func (p RetryPolicy) Backoff(retry int) time.Duration {
+	wait := float64(p.InitialBackoff)
+	if p.Multiplier > 1 {
+		for i := 1; i < retry; i++ {
+			wait *= p.Multiplier
+			if p.MaxBackoff > 0 && wait > float64(p.MaxBackoff) {
+				break
+			}
+		}
+	}
+	if p.MaxBackoff > 0 && wait > float64(p.MaxBackoff) {
+		return p.MaxBackoff
+	}
+	return time.Duration(wait)
+}
+
+// Retryable reports whether err has one of the retryable codes.
+func (p RetryPolicy) Retryable(err error) bool {
+	code := v1.Code_value["UNKNOWN"]
+	var re *repository.Error
+	if errors.As(err, &re) {
+		code = re.Code
+	}
+	for _, c := range p.RetryableCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,63 @@
+package task
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+	v1 "github.com/tinkerbell/pbnj/api/v1"
+	"github.com/tinkerbell/pbnj/pkg/repository"
+)
+
+func TestRetryPolicyOverride(t *testing.T) {
+	defaults := RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Second,
+		MaxBackoff:     30 * time.Second,
+		Multiplier:     2,
+		RetryableCodes: []int32{v1.Code_value["UNKNOWN"]},
+	}
+	got := defaults.Override(RetryPolicy{MaxAttempts: 5, RetryableCodes: []int32{v1.Code_value["UNAVAILABLE"]}})
+	expected := RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: time.Second,
+		MaxBackoff:     30 * time.Second,
+		Multiplier:     2,
+		RetryableCodes: []int32{v1.Code_value["UNAVAILABLE"]},
+	}
+	if diff := deep.Equal(expected, got); diff != nil {
+		t.Fatal(diff)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second, Multiplier: 2}
+	expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	for i, want := range expected {
+		if got := p.Backoff(i + 1); got != want {
+			t.Fatalf("retry %d: expected %v, got: %v", i+1, want, got)
+		}
+	}
+}
+
+func TestRetryPolicyRetryable(t *testing.T) {
+	unknown := RetryPolicy{RetryableCodes: []int32{v1.Code_value["UNKNOWN"]}}
+	tests := map[string]struct {
+		policy   RetryPolicy
+		err      error
+		expected bool
+	}{
+		"plain error is unknown":   {policy: unknown, err: errors.New("connection refused"), expected: true},
+		"retryable code":           {policy: unknown, err: &repository.Error{Code: v1.Code_value["UNKNOWN"]}, expected: true},
+		"non-retryable code":       {policy: unknown, err: &repository.Error{Code: v1.Code_value["INVALID_ARGUMENT"]}, expected: false},
+		"no codes retries nothing": {policy: RetryPolicy{}, err: errors.New("connection refused"), expected: false},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.policy.Retryable(tc.err); got != tc.expected {
+				t.Fatalf("expected %v, got: %v", tc.expected, got)
+			}
+		})
+	}
+}